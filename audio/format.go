@@ -0,0 +1,262 @@
+// Package audio 解析语音输出格式，并将多段合成的音频拼接为一个完整的音频
+// 支持 raw、riff（wav）、mp3、ogg、webm 及 amr 格式，不依赖 ffmpeg 等外部工具
+package audio
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnsupported 不支持的音频格式或操作
+var ErrUnsupported = errors.New("audio: unsupported format")
+
+// Container 音频的容器格式
+type Container string
+
+const (
+	ContainerRaw  Container = "raw"  // 无容器的音频数据
+	ContainerRIFF Container = "riff" // wav
+	ContainerMP3  Container = "mp3"
+	ContainerOgg  Container = "ogg"
+	ContainerWebM Container = "webm"
+	ContainerAMR  Container = "amr"
+)
+
+// Codec 音频编码
+type Codec string
+
+const (
+	CodecPCM   Codec = "pcm"
+	CodecALaw  Codec = "alaw"
+	CodecMuLaw Codec = "mulaw"
+	CodecMP3   Codec = "mp3"
+	CodecOpus  Codec = "opus"
+	CodecSILK  Codec = "truesilk"
+	CodecAMRWB Codec = "amr-wb"
+)
+
+// Format 音频格式
+type Format struct {
+	Name          string    // 格式名称，如 audio-24khz-48kbitrate-mono-mp3
+	Container     Container // 容器格式
+	Codec         Codec     // 编码
+	SampleRate    int       // 采样率（Hz）
+	BitsPerSample int       // 位深，未注明时为 0
+	Channels      int       // 声道数
+	Bitrate       int       // 码率（bit/s），未注明时为 0
+}
+
+// containerCodecs 各容器格式支持的编码
+var containerCodecs = map[Container][]Codec{
+	ContainerRaw:  {CodecPCM, CodecALaw, CodecMuLaw, CodecSILK},
+	ContainerRIFF: {CodecPCM, CodecALaw, CodecMuLaw},
+	ContainerMP3:  {CodecMP3},
+	ContainerOgg:  {CodecOpus},
+	ContainerWebM: {CodecOpus},
+	ContainerAMR:  {CodecAMRWB},
+}
+
+// ParseFormat 解析语音输出格式的名称，如 riff-24khz-16bit-mono-pcm、ogg-48khz-16bit-mono-opus
+// 编码须为名称的最后一部分且与容器格式匹配，各参数只能出现一次
+func ParseFormat(name string) (Format, error) {
+	f := Format{Name: name, Channels: 1}
+	unsupported := fmt.Errorf("%w: %s", ErrUnsupported, name)
+	parts := strings.Split(strings.ToLower(name), "-")
+	if len(parts) < 2 {
+		return f, unsupported
+	}
+
+	switch parts[0] {
+	case "raw":
+		f.Container = ContainerRaw
+	case "riff":
+		f.Container = ContainerRIFF
+	case "audio":
+		// audio-*-mp3 为 mp3，audio-*-opus 为 ogg 封装的 opus，由编码决定
+	case "ogg":
+		f.Container = ContainerOgg
+	case "webm":
+		f.Container = ContainerWebM
+	case "amr":
+		if parts[1] != "wb" {
+			return f, unsupported
+		}
+		f.Container = ContainerAMR
+		f.Codec = CodecAMRWB
+		parts = parts[1:] // 跳过 wb
+	default:
+		return f, unsupported
+	}
+
+	seen := make(map[string]bool)
+	for i, p := range parts[1:] {
+		var kind string
+		switch {
+		case p == "mono":
+			kind, f.Channels = "channels", 1
+		case p == "stereo":
+			kind, f.Channels = "channels", 2
+		case strings.HasSuffix(p, "khz"):
+			kind, f.SampleRate = "rate", atoi(strings.TrimSuffix(p, "khz"))*1000
+		case strings.HasSuffix(p, "hz"):
+			kind, f.SampleRate = "rate", atoi(strings.TrimSuffix(p, "hz"))
+		case strings.HasSuffix(p, "kbitrate"):
+			kind, f.Bitrate = "bitrate", atoi(strings.TrimSuffix(p, "kbitrate"))*1000
+		case strings.HasSuffix(p, "kbps"):
+			kind, f.Bitrate = "bitrate", atoi(strings.TrimSuffix(p, "kbps"))*1000
+		case strings.HasSuffix(p, "bit"):
+			kind, f.BitsPerSample = "bits", atoi(strings.TrimSuffix(p, "bit"))
+		case p == string(CodecPCM), p == string(CodecALaw), p == string(CodecMuLaw),
+			p == string(CodecMP3), p == string(CodecOpus), p == string(CodecSILK):
+			// 编码只能是最后一部分
+			if f.Codec != "" || i != len(parts)-2 {
+				return f, unsupported
+			}
+			kind, f.Codec = "codec", Codec(p)
+		default:
+			return f, unsupported
+		}
+		if seen[kind] {
+			return f, unsupported
+		}
+		seen[kind] = true
+	}
+
+	if parts[0] == "audio" {
+		switch f.Codec {
+		case CodecMP3:
+			f.Container = ContainerMP3
+		case CodecOpus:
+			f.Container = ContainerOgg
+		}
+	}
+	if f.Codec == "" || f.SampleRate == 0 || !containsCodec(containerCodecs[f.Container], f.Codec) {
+		return f, unsupported
+	}
+	if f.uncompressed() {
+		if f.BitsPerSample != 8 && f.BitsPerSample != 16 {
+			return f, unsupported
+		}
+		if f.Bitrate == 0 {
+			f.Bitrate = f.SampleRate * f.BitsPerSample * f.Channels
+		}
+	}
+	return f, nil
+}
+
+func containsCodec(codecs []Codec, c Codec) bool {
+	for _, v := range codecs {
+		if v == c {
+			return true
+		}
+	}
+	return false
+}
+
+// MimeType 音频的 MIME 类型，如 audio/mpeg，用于 http 响应的 Content-Type
+// raw 格式的 pcm 为 audio/pcm（小端序），alaw、mulaw 分别为 audio/PCMA、audio/PCMU
+func (f Format) MimeType() string {
+	switch f.Container {
+	case ContainerMP3:
+		return "audio/mpeg"
+	case ContainerOgg:
+		return "audio/ogg"
+	case ContainerWebM:
+		return "audio/webm"
+	case ContainerRIFF:
+		return "audio/wav"
+	case ContainerAMR:
+		return "audio/amr-wb"
+	}
+	switch f.Codec {
+	case CodecPCM:
+		return "audio/pcm"
+	case CodecALaw:
+		return "audio/PCMA"
+	case CodecMuLaw:
+		return "audio/PCMU"
+	}
+	return "application/octet-stream"
+}
+
+// Extension 音频文件的扩展名，如 .mp3，raw 格式为 .raw
+func (f Format) Extension() string {
+	switch f.Container {
+	case ContainerMP3:
+		return ".mp3"
+	case ContainerOgg:
+		return ".ogg"
+	case ContainerWebM:
+		return ".webm"
+	case ContainerRIFF:
+		return ".wav"
+	case ContainerAMR:
+		return ".amr"
+	}
+	return ".raw"
+}
+
+// SupportsSilence Writer.WriteSilence 是否支持该格式，raw、riff 格式仅支持 pcm、alaw、mulaw 编码
+// mp3、ogg、webm、amr 格式的静音参照第一段音频的参数，须在写入第一段之后插入
+func (f Format) SupportsSilence() bool {
+	switch f.Container {
+	case ContainerRaw, ContainerRIFF:
+		return f.uncompressed() && f.blockAlign() > 0
+	case ContainerMP3, ContainerOgg, ContainerWebM, ContainerAMR:
+		return true
+	}
+	return false
+}
+
+// opusFrame ogg、webm 中 Opus 静音包及 amr 中 NO_DATA 帧的时长
+const opusFrame = 20 * time.Millisecond
+
+// SilenceFrame Writer.WriteSilence 插入静音的最小单位：mp3、ogg、webm、amr 以编码帧为单位（mp3 为 24ms 或 26ms 等，
+// 其他为 20ms），静音时长按帧四舍五入；raw、riff 以采样为单位，返回 0
+func (f Format) SilenceFrame() time.Duration {
+	switch f.Container {
+	case ContainerMP3:
+		if f.SampleRate == 0 {
+			return 0
+		}
+		spf := 576
+		if f.SampleRate >= 32000 {
+			spf = 1152
+		}
+		return time.Duration(spf) * time.Second / time.Duration(f.SampleRate)
+	case ContainerOgg, ContainerWebM, ContainerAMR:
+		return opusFrame
+	}
+	return 0
+}
+
+// uncompressed 是否为未压缩的采样数据（pcm、alaw、mulaw）
+func (f Format) uncompressed() bool {
+	return f.Codec == CodecPCM || f.Codec == CodecALaw || f.Codec == CodecMuLaw
+}
+
+// silenceByte 未压缩采样数据中静音的字节值
+func (f Format) silenceByte() byte {
+	switch {
+	case f.Codec == CodecALaw:
+		return 0xD5
+	case f.Codec == CodecMuLaw:
+		return 0xFF
+	case f.BitsPerSample == 8:
+		return 0x80 // 8 位 pcm 为无符号数
+	}
+	return 0
+}
+
+// blockAlign 每个采样的字节数（所有声道）
+func (f Format) blockAlign() int {
+	return f.BitsPerSample / 8 * f.Channels
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
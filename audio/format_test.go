@@ -0,0 +1,87 @@
+package audio
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		want Format
+	}{
+		{"audio-24khz-48kbitrate-mono-mp3", Format{Container: ContainerMP3, Codec: CodecMP3, SampleRate: 24000, Channels: 1, Bitrate: 48000}},
+		{"audio-16khz-16bit-32kbps-mono-opus", Format{Container: ContainerOgg, Codec: CodecOpus, SampleRate: 16000, BitsPerSample: 16, Channels: 1, Bitrate: 32000}},
+		{"ogg-48khz-16bit-mono-opus", Format{Container: ContainerOgg, Codec: CodecOpus, SampleRate: 48000, BitsPerSample: 16, Channels: 1}},
+		{"webm-24khz-16bit-24kbps-mono-opus", Format{Container: ContainerWebM, Codec: CodecOpus, SampleRate: 24000, BitsPerSample: 16, Channels: 1, Bitrate: 24000}},
+		{"raw-22050hz-16bit-mono-pcm", Format{Container: ContainerRaw, Codec: CodecPCM, SampleRate: 22050, BitsPerSample: 16, Channels: 1, Bitrate: 352800}},
+		{"raw-8khz-8bit-mono-mulaw", Format{Container: ContainerRaw, Codec: CodecMuLaw, SampleRate: 8000, BitsPerSample: 8, Channels: 1, Bitrate: 64000}},
+		{"raw-24khz-16bit-mono-truesilk", Format{Container: ContainerRaw, Codec: CodecSILK, SampleRate: 24000, BitsPerSample: 16, Channels: 1}},
+		{"riff-48khz-16bit-mono-pcm", Format{Container: ContainerRIFF, Codec: CodecPCM, SampleRate: 48000, BitsPerSample: 16, Channels: 1, Bitrate: 768000}},
+		{"amr-wb-16000hz", Format{Container: ContainerAMR, Codec: CodecAMRWB, SampleRate: 16000, Channels: 1}},
+	}
+	for _, tt := range tests {
+		got, err := ParseFormat(tt.name)
+		tt.want.Name = tt.name
+		if err != nil || got != tt.want {
+			t.Errorf("ParseFormat(%s) = %+v, %v", tt.name, got, err)
+		}
+	}
+
+	for _, name := range []string{"", "mp3", "flac-24khz-16bit-mono-flac", "raw-24khz-16bit-mono-aac", "amr-nb-8000hz", "raw-16bit-mono-pcm",
+		"audio-24khz-48kbitrate-mono-pcm", "riff-7khz-mp3", "ogg-96khz-16bit-stereo-alaw", "raw-24khz-16bit-mono-pcm-pcm",
+		"raw-24khz-16khz-16bit-mono-pcm", "raw-24khz-mono-pcm", "riff-24khz-16bit-mono-truesilk", "raw-pcm-24khz-16bit-mono"} {
+		if _, err := ParseFormat(name); !errors.Is(err, ErrUnsupported) {
+			t.Errorf("ParseFormat(%q) err = %v", name, err)
+		}
+	}
+}
+
+func TestFormatMimeType(t *testing.T) {
+	for name, want := range map[string][2]string{
+		"audio-24khz-48kbitrate-mono-mp3":    {"audio/mpeg", ".mp3"},
+		"audio-16khz-16bit-32kbps-mono-opus": {"audio/ogg", ".ogg"},
+		"webm-24khz-16bit-mono-opus":         {"audio/webm", ".webm"},
+		"riff-24khz-16bit-mono-pcm":          {"audio/wav", ".wav"},
+		"raw-24khz-16bit-mono-pcm":           {"audio/pcm", ".raw"},
+		"raw-8khz-8bit-mono-alaw":            {"audio/PCMA", ".raw"},
+		"raw-16khz-16bit-mono-truesilk":      {"application/octet-stream", ".raw"},
+		"amr-wb-16000hz":                     {"audio/amr-wb", ".amr"},
+	} {
+		f := mustFormat(t, name)
+		if f.MimeType() != want[0] || f.Extension() != want[1] {
+			t.Errorf("%s = %s %s", name, f.MimeType(), f.Extension())
+		}
+	}
+}
+
+func TestFormatSupportsSilence(t *testing.T) {
+	for name, want := range map[string]bool{
+		"raw-24khz-16bit-mono-pcm":        true,
+		"raw-8khz-8bit-mono-mulaw":        true,
+		"raw-16khz-16bit-mono-truesilk":   false,
+		"riff-8khz-8bit-mono-alaw":        true,
+		"audio-24khz-48kbitrate-mono-mp3": true,
+		"ogg-24khz-16bit-mono-opus":       true,
+		"amr-wb-16000hz":                  true,
+	} {
+		if got := mustFormat(t, name).SupportsSilence(); got != want {
+			t.Errorf("%s SupportsSilence = %v", name, got)
+		}
+	}
+}
+
+func TestFormatSilenceFrame(t *testing.T) {
+	for name, want := range map[string]time.Duration{
+		"raw-24khz-16bit-mono-pcm":         0,
+		"audio-24khz-48kbitrate-mono-mp3":  24 * time.Millisecond,
+		"audio-48khz-192kbitrate-mono-mp3": 24 * time.Millisecond,
+		"ogg-24khz-16bit-mono-opus":        20 * time.Millisecond,
+		"amr-wb-16000hz":                   20 * time.Millisecond,
+	} {
+		if got := mustFormat(t, name).SilenceFrame(); got != want {
+			t.Errorf("%s SilenceFrame = %s", name, got)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package audio
+
+import (
+	"bytes"
+	"io"
+	"time"
+)
+
+// mp3Muxer 去掉各段的 ID3 标签及 Xing/Info/VBRI 帧后拼接
+// Xing 等帧记录的是单段的帧数及时长，保留会导致播放器显示错误的时长；仅保留第一段开头的 ID3v2 标签
+type mp3Muxer struct {
+	w      io.Writer
+	header *mp3Header // 第一个音频帧的帧头，用于生成静音帧
+}
+
+func (m *mp3Muxer) segment(data []byte, first bool) error {
+	tag, data := cutID3v2(data)
+	if first && len(tag) > 0 {
+		if _, err := m.w.Write(tag); err != nil {
+			return err
+		}
+	}
+
+	// ID3v1 标签固定为结尾的 128 字节
+	if len(data) >= 128 && string(data[len(data)-128:len(data)-125]) == "TAG" {
+		data = data[:len(data)-128]
+	}
+
+	if h, ok := parseMP3Header(data); ok {
+		if h.isInfoFrame(data) {
+			data = data[min(h.frameSize(), len(data)):]
+			h, ok = parseMP3Header(data)
+		}
+		if ok && m.header == nil {
+			m.header = h
+		}
+	}
+
+	_, err := m.w.Write(data)
+	return err
+}
+
+// silence 按第一个音频帧的参数生成静音帧：边信息及主数据全为 0 时解码为静音
+func (m *mp3Muxer) silence(d time.Duration) error {
+	if m.header == nil {
+		return errNoSegment
+	}
+
+	h := *m.header
+	h.padding = false
+	h.crc = false
+	frame := make([]byte, h.frameSize())
+	copy(frame, h.bytes())
+
+	n, err := silenceFrames(d, time.Duration(h.samplesPerFrame())*time.Second/time.Duration(h.sampleRate))
+	if err != nil {
+		return err
+	}
+	_, err = m.w.Write(bytes.Repeat(frame, n))
+	return err
+}
+
+func (m *mp3Muxer) close() error {
+	return nil
+}
+
+// cutID3v2 拆分开头的 ID3v2 标签
+func cutID3v2(data []byte) (tag, rest []byte) {
+	if len(data) < 10 || string(data[:3]) != "ID3" {
+		return nil, data
+	}
+	// 长度为 4 个 7 位的字节，不含 10 字节的标签头；flags 的第 4 位表示有 10 字节的标签尾
+	size := int(data[6])<<21 | int(data[7])<<14 | int(data[8])<<7 | int(data[9])
+	size += 10
+	if data[5]&0x10 != 0 {
+		size += 10
+	}
+	size = min(size, len(data))
+	return data[:size], data[size:]
+}
+
+// mp3Header MPEG Layer III 帧头
+type mp3Header struct {
+	raw        [4]byte
+	version    int // 3 为 MPEG1，2 为 MPEG2，0 为 MPEG2.5
+	crc        bool
+	bitrate    int // bit/s
+	sampleRate int
+	padding    bool
+	mono       bool
+}
+
+var (
+	mp3Bitrates1 = [16]int{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320}
+	mp3Bitrates2 = [16]int{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160}
+	mp3Rates     = [3]int{44100, 48000, 32000}
+)
+
+func parseMP3Header(data []byte) (*mp3Header, bool) {
+	if len(data) < 4 || data[0] != 0xFF || data[1]&0xE0 != 0xE0 {
+		return nil, false
+	}
+
+	h := &mp3Header{}
+	copy(h.raw[:], data[:4])
+	h.version = int(data[1]>>3) & 3
+	layer := int(data[1]>>1) & 3
+	bitrateIndex := int(data[2] >> 4)
+	rateIndex := int(data[2]>>2) & 3
+	if h.version == 1 || layer != 1 || bitrateIndex == 0 || bitrateIndex == 15 || rateIndex == 3 {
+		return nil, false
+	}
+
+	h.crc = data[1]&1 == 0
+	h.padding = data[2]&0x02 != 0
+	h.mono = data[3]>>6 == 3
+	h.sampleRate = mp3Rates[rateIndex]
+	if h.version == 3 {
+		h.bitrate = mp3Bitrates1[bitrateIndex] * 1000
+	} else {
+		h.bitrate = mp3Bitrates2[bitrateIndex] * 1000
+		h.sampleRate /= 2
+		if h.version == 0 {
+			h.sampleRate /= 2
+		}
+	}
+	return h, true
+}
+
+func (h *mp3Header) samplesPerFrame() int {
+	if h.version == 3 {
+		return 1152
+	}
+	return 576
+}
+
+func (h *mp3Header) frameSize() int {
+	size := h.samplesPerFrame() / 8 * h.bitrate / h.sampleRate
+	if h.padding {
+		size++
+	}
+	return size
+}
+
+// sideInfoSize 帧头之后边信息的字节数
+func (h *mp3Header) sideInfoSize() int {
+	switch {
+	case h.version == 3 && h.mono:
+		return 17
+	case h.version == 3:
+		return 32
+	case h.mono:
+		return 9
+	}
+	return 17
+}
+
+// isInfoFrame 是否为 Xing、Info（位于边信息之后）或 VBRI（位于帧头之后 32 字节）帧
+func (h *mp3Header) isInfoFrame(data []byte) bool {
+	offset := 4 + h.sideInfoSize()
+	if h.crc {
+		offset += 2
+	}
+	if len(data) >= offset+4 {
+		if tag := string(data[offset : offset+4]); tag == "Xing" || tag == "Info" {
+			return true
+		}
+	}
+	return len(data) >= 40 && string(data[36:40]) == "VBRI"
+}
+
+// bytes 按当前的 crc 及 padding 生成帧头
+func (h *mp3Header) bytes() []byte {
+	b := h.raw
+	b[1] |= 1
+	if h.crc {
+		b[1] &^= 1
+	}
+	b[2] &^= 0x02
+	if h.padding {
+		b[2] |= 0x02
+	}
+	return b[:]
+}
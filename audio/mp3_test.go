@@ -0,0 +1,76 @@
+package audio
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+// mp3Frame MPEG2 Layer III 24kHz 48kbps 单声道的帧，长度 144 字节
+func mp3Frame(fill byte) []byte {
+	frame := bytes.Repeat([]byte{fill}, 144)
+	copy(frame, []byte{0xFF, 0xF3, 0x64, 0xC0})
+	return frame
+}
+
+func xingFrame() []byte {
+	frame := make([]byte, 144)
+	copy(frame, []byte{0xFF, 0xF3, 0x64, 0xC0})
+	copy(frame[4+9:], "Xing")
+	return frame
+}
+
+func newMP3(fill byte) []byte {
+	var b []byte
+	b = append(b, "ID3\x03\x00\x00\x00\x00\x00\x0a"...)
+	b = append(b, bytes.Repeat([]byte{'i'}, 10)...)
+	b = append(b, xingFrame()...)
+	b = append(b, mp3Frame(fill)...)
+	b = append(b, mp3Frame(fill)...)
+	b = append(b, "TAG"...)
+	return append(b, make([]byte, 125)...)
+}
+
+func TestConcatMP3(t *testing.T) {
+	out, err := Concat(mustFormat(t, "audio-24khz-48kbitrate-mono-mp3"), [][]byte{newMP3(1), newMP3(2)}, 100*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 保留第一段的 ID3v2，去掉 Xing 帧及 ID3v1；100ms 为 4 个 576 采样的静音帧
+	if !bytes.HasPrefix(out, []byte("ID3")) || bytes.Count(out, []byte("ID3")) != 1 {
+		t.Error("ID3v2 标签应只保留一个")
+	}
+	if bytes.Contains(out, []byte("Xing")) || bytes.Contains(out, []byte("TAG")) {
+		t.Error("应去掉 Xing 帧及 ID3v1 标签")
+	}
+	if len(out) != 20+8*144 {
+		t.Fatalf("len = %d", len(out))
+	}
+
+	frames := out[20:]
+	for i := 0; i < 8; i++ {
+		frame := frames[i*144 : (i+1)*144]
+		h, ok := parseMP3Header(frame)
+		if !ok || h.frameSize() != 144 {
+			t.Fatalf("第 %d 帧无效", i)
+		}
+		if i >= 2 && i < 6 && !bytes.Equal(frame[4:], make([]byte, 140)) {
+			t.Errorf("第 %d 帧应为静音帧", i)
+		}
+	}
+	if frames[6*144+10] != 2 {
+		t.Error("第二段的音频位置错误")
+	}
+}
+
+func TestMP3Header(t *testing.T) {
+	// MPEG1 44.1kHz 128kbps 带填充
+	h, ok := parseMP3Header([]byte{0xFF, 0xFB, 0x92, 0x00})
+	if !ok || h.sampleRate != 44100 || h.bitrate != 128000 || h.frameSize() != 418 || h.samplesPerFrame() != 1152 {
+		t.Errorf("header = %+v", h)
+	}
+	if _, ok := parseMP3Header([]byte{0xFF, 0xFD, 0x90, 0x00}); ok {
+		t.Error("Layer II 不应解析")
+	}
+}
@@ -0,0 +1,201 @@
+package audio
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+)
+
+// opusSilence 20ms 的 Opus 静音包（CELT 全频带，单声道）
+var opusSilence = []byte{0xF8, 0xFF, 0xFE}
+
+// opusFrameSamples 20ms 在 48kHz 下的采样数，Ogg Opus 的 granule 始终以 48kHz 计
+const opusFrameSamples = 960
+
+const (
+	oggFlagContinued = 0x01
+	oggFlagBOS       = 0x02
+	oggFlagEOS       = 0x04
+)
+
+// oggPage Ogg 页
+type oggPage struct {
+	flags   byte
+	granule int64
+	serial  uint32
+	lacing  []byte
+	body    []byte
+}
+
+// oggMuxer 重新封装各段的 Ogg 页：跳过后续各段的 OpusHead、OpusTags 页，
+// 统一使用第一段的流序号，连续编号页序号，并将 granule 累加为连续的位置
+//
+// 限制：解码器只在流的开头按 OpusHead 的 pre-skip 丢弃编码器预热的采样（通常 312 个，约 6.5ms），
+// 后续各段的预热采样会在拼接处播放出来；Opus 包无法在不重新编码的情况下截取一部分，
+// Ogg 的结尾裁剪也只能用于流的最后一页，因此保留这些采样。合成的音频开头通常为静音，一般听不出来
+type oggMuxer struct {
+	w       io.Writer
+	serial  uint32
+	seq     uint32
+	granule int64  // 已写入的最后位置
+	pending []byte // 暂存的最后一页，Close 时设置 EOS 标志
+	started bool
+}
+
+func (m *oggMuxer) segment(data []byte, first bool) error {
+	pages, err := parseOggPages(data)
+	if err != nil {
+		return err
+	}
+
+	base := m.granule
+	header := true
+	for _, p := range pages {
+		// OpusHead 及 OpusTags 页的 granule 均为 0
+		if header && p.granule == 0 && m.started && !first {
+			continue
+		}
+		if !m.started {
+			m.serial = p.serial
+		}
+		header = header && p.granule == 0
+
+		if p.granule != -1 {
+			p.granule += base
+			m.granule = p.granule
+		}
+		p.flags &^= oggFlagEOS
+		if m.started {
+			p.flags &^= oggFlagBOS
+		}
+		if err := m.emit(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// silence 每页 50 个 20ms 的静音包
+func (m *oggMuxer) silence(d time.Duration) error {
+	if !m.started {
+		return errNoSegment
+	}
+
+	n, err := silenceFrames(d, opusFrame)
+	if err != nil {
+		return err
+	}
+	for n > 0 {
+		packets := min(n, 50)
+		p := &oggPage{lacing: make([]byte, packets)}
+		for i := range p.lacing {
+			p.lacing[i] = byte(len(opusSilence))
+			p.body = append(p.body, opusSilence...)
+		}
+		m.granule += int64(packets * opusFrameSamples)
+		p.granule = m.granule
+		if err := m.emit(p); err != nil {
+			return err
+		}
+		n -= packets
+	}
+	return nil
+}
+
+func (m *oggMuxer) close() error {
+	if m.pending == nil {
+		return nil
+	}
+	m.pending[5] |= oggFlagEOS
+	binary.LittleEndian.PutUint32(m.pending[22:], 0)
+	binary.LittleEndian.PutUint32(m.pending[22:], oggCRC(m.pending))
+	_, err := m.w.Write(m.pending)
+	m.pending = nil
+	return err
+}
+
+// emit 写入上一页并暂存当前页
+func (m *oggMuxer) emit(p *oggPage) error {
+	p.serial = m.serial
+	page := p.bytes(m.seq)
+	m.seq++
+	m.started = true
+
+	if m.pending != nil {
+		if _, err := m.w.Write(m.pending); err != nil {
+			return err
+		}
+	}
+	m.pending = page
+	return nil
+}
+
+func (p *oggPage) bytes(seq uint32) []byte {
+	b := make([]byte, 0, 27+len(p.lacing)+len(p.body))
+	b = append(b, "OggS"...)
+	b = append(b, 0, p.flags)
+	b = binary.LittleEndian.AppendUint64(b, uint64(p.granule))
+	b = binary.LittleEndian.AppendUint32(b, p.serial)
+	b = binary.LittleEndian.AppendUint32(b, seq)
+	b = binary.LittleEndian.AppendUint32(b, 0)
+	b = append(b, byte(len(p.lacing)))
+	b = append(b, p.lacing...)
+	b = append(b, p.body...)
+	binary.LittleEndian.PutUint32(b[22:], oggCRC(b))
+	return b
+}
+
+func parseOggPages(data []byte) ([]*oggPage, error) {
+	var pages []*oggPage
+	for len(data) > 0 {
+		if len(data) < 27 || string(data[:4]) != "OggS" {
+			return nil, errors.New("audio: invalid ogg page")
+		}
+		n := int(data[26])
+		if len(data) < 27+n {
+			return nil, errors.New("audio: truncated ogg page")
+		}
+		size := 0
+		for _, l := range data[27 : 27+n] {
+			size += int(l)
+		}
+		if len(data) < 27+n+size {
+			return nil, errors.New("audio: truncated ogg page")
+		}
+
+		pages = append(pages, &oggPage{
+			flags:   data[5],
+			granule: int64(binary.LittleEndian.Uint64(data[6:14])),
+			serial:  binary.LittleEndian.Uint32(data[14:18]),
+			lacing:  data[27 : 27+n],
+			body:    data[27+n : 27+n+size],
+		})
+		data = data[27+n+size:]
+	}
+	return pages, nil
+}
+
+var oggCRCTable = func() (t [256]uint32) {
+	for i := range t {
+		r := uint32(i) << 24
+		for j := 0; j < 8; j++ {
+			if r&0x80000000 != 0 {
+				r = r<<1 ^ 0x04C11DB7
+			} else {
+				r <<= 1
+			}
+		}
+		t[i] = r
+	}
+	return t
+}()
+
+// oggCRC Ogg 页的校验和，计算时校验和字段须为 0
+func oggCRC(b []byte) uint32 {
+	var crc uint32
+	for _, v := range b {
+		crc = crc<<8 ^ oggCRCTable[byte(crc>>24)^v]
+	}
+	return crc
+}
@@ -0,0 +1,67 @@
+package audio
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+)
+
+func newOgg(serial uint32) []byte {
+	pages := []*oggPage{
+		{flags: oggFlagBOS, lacing: []byte{19}, body: []byte("OpusHead\x01\x01\x38\x01\x80\xbb\x00\x00\x00\x00\x00")},
+		{lacing: []byte{16}, body: []byte("OpusTags\x00\x00\x00\x00\x00\x00\x00\x00")},
+		{granule: 960, lacing: []byte{3}, body: []byte{1, 2, 3}},
+		{flags: oggFlagEOS, granule: 1920, lacing: []byte{3}, body: []byte{4, 5, 6}},
+	}
+	var b []byte
+	for i, p := range pages {
+		p.serial = serial
+		b = append(b, p.bytes(uint32(i))...)
+	}
+	return b
+}
+
+func TestConcatOgg(t *testing.T) {
+	out, err := Concat(mustFormat(t, "ogg-24khz-16bit-mono-opus"), [][]byte{newOgg(1), newOgg(2)}, 40*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pages, err := parseOggPages(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantGranules := []int64{0, 0, 960, 1920, 3840, 4800, 5760}
+	if len(pages) != len(wantGranules) {
+		t.Fatalf("pages = %d", len(pages))
+	}
+
+	offset := 0
+	for i, p := range pages {
+		raw := out[offset : offset+27+len(p.lacing)+len(p.body)]
+		offset += len(raw)
+
+		if p.granule != wantGranules[i] || p.serial != 1 || binary.LittleEndian.Uint32(raw[18:]) != uint32(i) {
+			t.Errorf("第 %d 页 granule = %d, serial = %d", i, p.granule, p.serial)
+		}
+		if (p.flags&oggFlagBOS != 0) != (i == 0) || (p.flags&oggFlagEOS != 0) != (i == len(pages)-1) {
+			t.Errorf("第 %d 页 flags = %x", i, p.flags)
+		}
+		crc := binary.LittleEndian.Uint32(raw[22:])
+		check := append([]byte(nil), raw...)
+		binary.LittleEndian.PutUint32(check[22:], 0)
+		if oggCRC(check) != crc {
+			t.Errorf("第 %d 页校验和错误", i)
+		}
+	}
+	if len(pages[4].lacing) != 2 || pages[4].body[0] != opusSilence[0] {
+		t.Errorf("静音页 = %+v", pages[4])
+	}
+}
+
+func TestOggCRC(t *testing.T) {
+	// CRC-32/CKSUM 的校验值为 0x765E7680，Ogg 不做最终异或
+	if crc := oggCRC([]byte("123456789")); crc != 0x765E7680^0xFFFFFFFF {
+		t.Errorf("crc = %x", crc)
+	}
+}
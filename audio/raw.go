@@ -0,0 +1,76 @@
+package audio
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+)
+
+// rawMuxer 无容器的音频数据直接拼接，静音为对应编码的零值采样
+type rawMuxer struct {
+	w      io.Writer
+	format Format
+}
+
+func (m *rawMuxer) segment(data []byte, first bool) error {
+	_, err := m.w.Write(data)
+	return err
+}
+
+func (m *rawMuxer) silence(d time.Duration) error {
+	if !m.format.uncompressed() || m.format.blockAlign() == 0 {
+		return fmt.Errorf("%w: silence for %s", ErrUnsupported, m.format.Name)
+	}
+	return writeSilence(m.w, m.format, d)
+}
+
+func (m *rawMuxer) close() error {
+	return nil
+}
+
+func writeSilence(w io.Writer, format Format, d time.Duration) error {
+	n := samples(d, format.SampleRate) * format.blockAlign()
+	_, err := w.Write(bytes.Repeat([]byte{format.silenceByte()}, n))
+	return err
+}
+
+const amrWbHeader = "#!AMR-WB\n"
+
+// amrNoData AMR-WB 的 NO_DATA 帧（FT=15），每帧 20ms
+const amrNoData = 15<<3 | 0x04
+
+// amrMuxer 去掉后续各段的 #!AMR-WB 文件头后拼接
+type amrMuxer struct {
+	w       io.Writer
+	started bool
+}
+
+func (m *amrMuxer) segment(data []byte, first bool) error {
+	if m.started {
+		data = bytes.TrimPrefix(data, []byte(amrWbHeader))
+	} else if !bytes.HasPrefix(data, []byte(amrWbHeader)) {
+		if _, err := io.WriteString(m.w, amrWbHeader); err != nil {
+			return err
+		}
+	}
+	m.started = true
+	_, err := m.w.Write(data)
+	return err
+}
+
+func (m *amrMuxer) silence(d time.Duration) error {
+	if !m.started {
+		return errNoSegment
+	}
+	n, err := silenceFrames(d, opusFrame)
+	if err != nil {
+		return err
+	}
+	_, err = m.w.Write(bytes.Repeat([]byte{amrNoData}, n))
+	return err
+}
+
+func (m *amrMuxer) close() error {
+	return nil
+}
@@ -0,0 +1,179 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+)
+
+// riffUnknownSize 流式写入时未知的长度
+const riffUnknownSize = 0xFFFFFFFF
+
+// riffMuxer 取出各段 wav 的 data 块拼接，并重新生成文件头
+type riffMuxer struct {
+	w       io.Writer
+	format  Format
+	fmtData []byte // 第一段的 fmt 块
+	dataLen int64
+}
+
+func (m *riffMuxer) segment(data []byte, first bool) error {
+	fmtData, pcm, err := parseWav(data)
+	if err != nil {
+		return err
+	}
+
+	if m.fmtData == nil {
+		m.fmtData = bytes.Clone(fmtData)
+		if err := m.writeHeader(); err != nil {
+			return err
+		}
+	} else if !bytes.Equal(fmtData, m.fmtData) {
+		return ErrFormatMismatch
+	}
+
+	n, err := m.w.Write(pcm)
+	m.dataLen += int64(n)
+	return err
+}
+
+func (m *riffMuxer) silence(d time.Duration) error {
+	if m.fmtData == nil {
+		if !m.format.uncompressed() || m.format.blockAlign() == 0 {
+			return errNoSegment
+		}
+		m.fmtData = wavFormatChunk(m.format)
+		if err := m.writeHeader(); err != nil {
+			return err
+		}
+	}
+
+	// 按 fmt 块中的字节率及块对齐计算静音的长度
+	byteRate := int64(binary.LittleEndian.Uint32(m.fmtData[8:12]))
+	blockAlign := int64(binary.LittleEndian.Uint16(m.fmtData[12:14]))
+	if blockAlign == 0 {
+		return ErrUnsupported
+	}
+	n := int64(d) * byteRate / int64(time.Second) / blockAlign * blockAlign
+
+	silence := byte(0)
+	switch binary.LittleEndian.Uint16(m.fmtData[0:2]) {
+	case wavFormatALaw:
+		silence = 0xD5
+	case wavFormatMuLaw:
+		silence = 0xFF
+	case wavFormatPCM:
+		if binary.LittleEndian.Uint16(m.fmtData[14:16]) == 8 {
+			silence = 0x80
+		}
+	}
+	written, err := m.w.Write(bytes.Repeat([]byte{silence}, int(n)))
+	m.dataLen += int64(written)
+	return err
+}
+
+func (m *riffMuxer) writeHeader() error {
+	_, err := m.w.Write(wavHeader(m.fmtData, riffUnknownSize))
+	return err
+}
+
+// close 补齐奇数长度的 data 块，w 支持 WriteAt 时回填文件头中的长度
+func (m *riffMuxer) close() error {
+	if m.fmtData == nil {
+		return nil
+	}
+	if m.dataLen%2 == 1 {
+		if _, err := m.w.Write([]byte{0}); err != nil {
+			return err
+		}
+	}
+
+	wa, ok := m.w.(io.WriterAt)
+	if !ok || m.dataLen > riffUnknownSize-64 {
+		return nil
+	}
+	_, err := wa.WriteAt(wavHeader(m.fmtData, m.dataLen), 0)
+	return err
+}
+
+// wav 的 fmt 块中的编码
+const (
+	wavFormatPCM   = 1
+	wavFormatALaw  = 6
+	wavFormatMuLaw = 7
+)
+
+// wavFormatChunk 根据格式生成 wav 的 fmt 块内容（不含块头），用于 pcm、alaw、mulaw
+func wavFormatChunk(format Format) []byte {
+	tag := uint16(wavFormatPCM)
+	switch format.Codec {
+	case CodecALaw:
+		tag = wavFormatALaw
+	case CodecMuLaw:
+		tag = wavFormatMuLaw
+	}
+
+	b := make([]byte, 16)
+	binary.LittleEndian.PutUint16(b[0:], tag)
+	binary.LittleEndian.PutUint16(b[2:], uint16(format.Channels))
+	binary.LittleEndian.PutUint32(b[4:], uint32(format.SampleRate))
+	binary.LittleEndian.PutUint32(b[8:], uint32(format.SampleRate*format.blockAlign()))
+	binary.LittleEndian.PutUint16(b[12:], uint16(format.blockAlign()))
+	binary.LittleEndian.PutUint16(b[14:], uint16(format.BitsPerSample))
+	return b
+}
+
+// wavHeader 生成 wav 文件头，dataLen 为 riffUnknownSize 时长度均为 0xFFFFFFFF
+func wavHeader(fmtData []byte, dataLen int64) []byte {
+	riffLen := int64(riffUnknownSize)
+	if dataLen != riffUnknownSize {
+		riffLen = 4 + 8 + int64(len(fmtData)) + 8 + dataLen + dataLen%2
+	}
+
+	b := make([]byte, 0, 12+8+len(fmtData)+8)
+	b = append(b, "RIFF"...)
+	b = binary.LittleEndian.AppendUint32(b, uint32(riffLen))
+	b = append(b, "WAVEfmt "...)
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(fmtData)))
+	b = append(b, fmtData...)
+	b = append(b, "data"...)
+	b = binary.LittleEndian.AppendUint32(b, uint32(dataLen))
+	return b
+}
+
+// parseWav 返回 wav 的 fmt 块内容及采样数据
+// 流式生成的 wav 的长度可能为 0 或 0xFFFFFFFF，此时 data 块为之后的全部数据
+func parseWav(data []byte) (fmtData, pcm []byte, err error) {
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WAVE" {
+		return nil, nil, errors.New("audio: invalid wav header")
+	}
+
+	for p := 12; p+8 <= len(data); {
+		id := string(data[p : p+4])
+		size := int64(binary.LittleEndian.Uint32(data[p+4 : p+8]))
+		body := data[p+8:]
+
+		switch id {
+		case "fmt ":
+			if size < 16 || size > int64(len(body)) {
+				return nil, nil, errors.New("audio: invalid wav fmt chunk")
+			}
+			fmtData = body[:size]
+		case "data":
+			if fmtData == nil {
+				return nil, nil, errors.New("audio: wav data chunk before fmt chunk")
+			}
+			if size == 0 || size > int64(len(body)) {
+				size = int64(len(body))
+			}
+			return fmtData, body[:size], nil
+		}
+		if size > int64(len(body)) {
+			break
+		}
+		p += 8 + int(size) + int(size%2)
+	}
+	return nil, nil, errors.New("audio: wav data chunk not found")
+}
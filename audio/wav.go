@@ -0,0 +1,68 @@
+package audio
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// wavFormat 检查能否封装为 wav：仅支持 raw 的 pcm、alaw、mulaw
+func wavFormat(format Format) ([]byte, error) {
+	if format.Container != ContainerRaw || !format.uncompressed() || format.blockAlign() == 0 {
+		return nil, fmt.Errorf("%w: wav from %s", ErrUnsupported, format.Name)
+	}
+	return wavFormatChunk(format), nil
+}
+
+// NewWavReader 为 raw 的 pcm、alaw、mulaw 音频数据加上 wav 文件头，边读取边转换，可直接用于 http 响应或播放器
+// dataLen 为音频数据的字节数，未知时传 -1，此时文件头中的长度为 0xFFFFFFFF，多数播放器视为读取至结尾
+func NewWavReader(r io.Reader, format Format, dataLen int64) (io.Reader, error) {
+	fmtData, err := wavFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	if dataLen < 0 || dataLen > riffUnknownSize-64 {
+		dataLen = riffUnknownSize
+	}
+	return io.MultiReader(bytes.NewReader(wavHeader(fmtData, dataLen)), r), nil
+}
+
+// WavWriter 将 raw 的 pcm、alaw、mulaw 音频数据写入为 wav
+// w 实现了 io.WriterAt（如 *os.File）时，Close 会在偏移 0 处回填实际长度，否则长度为 0xFFFFFFFF
+type WavWriter struct {
+	m      *riffMuxer
+	closed bool
+}
+
+// NewWavWriter 创建 WavWriter 并写入文件头
+func NewWavWriter(w io.Writer, format Format) (*WavWriter, error) {
+	fmtData, err := wavFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	m := &riffMuxer{w: w, format: format, fmtData: fmtData}
+	if err := m.writeHeader(); err != nil {
+		return nil, err
+	}
+	return &WavWriter{m: m}, nil
+}
+
+// Write 写入音频数据
+func (w *WavWriter) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, errors.New("audio: write to closed writer")
+	}
+	n, err := w.m.w.Write(p)
+	w.m.dataLen += int64(n)
+	return n, err
+}
+
+// Close 补齐奇数长度的数据并回填文件头，不会关闭底层的 io.Writer
+func (w *WavWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	return w.m.close()
+}
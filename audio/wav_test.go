@@ -0,0 +1,78 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewWavReader(t *testing.T) {
+	f := mustFormat(t, "raw-8khz-8bit-mono-mulaw")
+	r, err := NewWavReader(strings.NewReader("abc"), f, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := io.ReadAll(r)
+
+	fmtData, pcm, err := parseWav(data)
+	if err != nil || string(pcm) != "abc" {
+		t.Fatalf("pcm = %q, err = %v", pcm, err)
+	}
+	if binary.LittleEndian.Uint16(fmtData) != wavFormatMuLaw || binary.LittleEndian.Uint32(fmtData[4:]) != 8000 {
+		t.Errorf("fmt = %v", fmtData)
+	}
+	if size := binary.LittleEndian.Uint32(data[4:]); size != 36+4 {
+		t.Errorf("RIFF 长度 = %d", size)
+	}
+
+	// 长度未知
+	r, _ = NewWavReader(strings.NewReader("abc"), f, -1)
+	data, _ = io.ReadAll(r)
+	if size := binary.LittleEndian.Uint32(data[40:]); size != riffUnknownSize {
+		t.Errorf("data 长度 = %x", size)
+	}
+
+	for _, name := range []string{"audio-24khz-48kbitrate-mono-mp3", "riff-24khz-16bit-mono-pcm", "raw-24khz-16bit-mono-truesilk"} {
+		if _, err := NewWavReader(strings.NewReader(""), mustFormat(t, name), 0); !errors.Is(err, ErrUnsupported) {
+			t.Errorf("%s err = %v", name, err)
+		}
+	}
+}
+
+func TestWavWriter(t *testing.T) {
+	f := mustFormat(t, "raw-16khz-16bit-mono-pcm")
+	path := filepath.Join(t.TempDir(), "out.wav")
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	w, err := NewWavWriter(file, f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = w.Write([]byte{1, 2})
+	_, _ = w.Write([]byte{3, 4, 5})
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, _ := os.ReadFile(path)
+	_, pcm, err := parseWav(data)
+	if err != nil || !bytes.Equal(pcm, []byte{1, 2, 3, 4, 5}) {
+		t.Fatalf("pcm = %v, err = %v", pcm, err)
+	}
+	// 奇数长度补齐 1 字节
+	if len(data) != 44+6 || binary.LittleEndian.Uint32(data[4:]) != 36+6 {
+		t.Errorf("len = %d, RIFF 长度 = %d", len(data), binary.LittleEndian.Uint32(data[4:]))
+	}
+	if _, err := w.Write([]byte{1}); err == nil {
+		t.Error("关闭后写入应返回错误")
+	}
+}
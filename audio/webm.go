@@ -0,0 +1,385 @@
+package audio
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+// WebM（Matroska）元素 ID
+const (
+	ebmlHeaderID     = 0x1A45DFA3
+	ebmlSegmentID    = 0x18538067
+	ebmlInfoID       = 0x1549A966
+	ebmlScaleID      = 0x2AD7B1
+	ebmlDurationID   = 0x4489
+	ebmlTracksID     = 0x1654AE6B
+	ebmlClusterID    = 0x1F43B675
+	ebmlVoidID       = 0xEC
+	ebmlTimecodeID   = 0xE7
+	ebmlPositionID   = 0xA7
+	ebmlPrevSizeID   = 0xAB
+	ebmlSimpleBlock  = 0xA3
+	ebmlBlockGroupID = 0xA0
+	ebmlBlockID      = 0xA1
+)
+
+// ebmlUnknownSize 长度未知的元素，如流式生成的 Segment 及 Cluster
+const ebmlUnknownSize = -1
+
+// webmMuxer 重新封装各段的 Cluster：保留第一段的 EBML 头、Info 及 Tracks，
+// 后续各段只取 Cluster 并将其时间码顺延；SeekHead、Cues 中的位置及 Info 中的时长在拼接后失效，因此去掉
+// 与 oggMuxer 相同，后续各段开头的编码器预热采样（CodecDelay）会保留在拼接处
+type webmMuxer struct {
+	w       io.Writer
+	started bool
+	scale   int64 // 时间码的单位（ns），取自第一段的 Info
+	end     int64 // 已写入音频的结束时间码
+	track   int64 // 音轨号，用于生成静音块
+}
+
+// ebmlElement EBML 元素
+type ebmlElement struct {
+	id   uint32
+	data []byte // 元素内容，长度未知的元素参见 ebmlUnknownEnd
+}
+
+func (m *webmMuxer) segment(data []byte, first bool) error {
+	elems, err := parseEBML(data)
+	if err != nil {
+		return err
+	}
+
+	base := m.end
+	found := false
+	for _, e := range elems {
+		switch e.id {
+		case ebmlHeaderID:
+			if !m.started {
+				if _, err := m.w.Write(ebmlEncode(e.id, e.data)); err != nil {
+					return err
+				}
+			}
+		case ebmlSegmentID:
+			children, err := parseEBML(e.data)
+			if err != nil {
+				return err
+			}
+			if !m.started {
+				// Segment 的长度未知，之后的 Cluster 均写入其中
+				hdr := binary.BigEndian.AppendUint32(nil, ebmlSegmentID)
+				hdr = append(hdr, 0x01, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF)
+				if _, err := m.w.Write(hdr); err != nil {
+					return err
+				}
+			}
+			for _, c := range children {
+				if err := m.segmentChild(c, base, !m.started); err != nil {
+					return err
+				}
+			}
+			m.started = true
+			found = true
+		}
+	}
+	if !found {
+		return errors.New("audio: webm segment not found")
+	}
+	return nil
+}
+
+func (m *webmMuxer) segmentChild(e ebmlElement, base int64, first bool) error {
+	switch e.id {
+	case ebmlInfoID:
+		if m.scale == 0 {
+			m.scale = infoScale(e.data)
+		}
+		if first {
+			_, err := m.w.Write(ebmlEncode(e.id, removeEBML(e.data, ebmlDurationID)))
+			return err
+		}
+	case ebmlTracksID:
+		if first {
+			_, err := m.w.Write(ebmlEncode(e.id, e.data))
+			return err
+		}
+	case ebmlClusterID:
+		return m.cluster(e.data, base)
+	}
+	return nil
+}
+
+// cluster 顺延 Cluster 的时间码，并记录最后一个块的结束时间
+func (m *webmMuxer) cluster(data []byte, base int64) error {
+	children, err := parseEBML(data)
+	if err != nil {
+		return err
+	}
+
+	var timecode int64
+	var out []byte
+	var last, step int64 = -1, 0
+	for _, c := range children {
+		switch c.id {
+		case ebmlTimecodeID:
+			timecode = int64(ebmlUint(c.data))
+		case ebmlPositionID, ebmlPrevSizeID:
+			// 拼接后位置失效
+		case ebmlSimpleBlock, ebmlBlockGroupID:
+			block := c.data
+			if c.id == ebmlBlockGroupID {
+				block = findEBML(c.data, ebmlBlockID)
+			}
+			if track, rel, ok := parseBlock(block); ok {
+				if m.track == 0 {
+					m.track = track
+				}
+				t := timecode + rel
+				if last >= 0 && t > last {
+					step = t - last
+				}
+				last = t
+			}
+			out = append(out, ebmlEncode(c.id, c.data)...)
+		default:
+			out = append(out, ebmlEncode(c.id, c.data)...)
+		}
+	}
+
+	if last >= 0 {
+		if step == 0 {
+			step = max(m.frameTicks(), 1)
+		}
+		m.end = max(m.end, base+last+step)
+	}
+
+	body := ebmlEncode(ebmlTimecodeID, ebmlUintBytes(uint64(base+timecode)))
+	_, err = m.w.Write(ebmlEncode(ebmlClusterID, append(body, out...)))
+	return err
+}
+
+// silence 写入 20ms 的 Opus 静音块，块在 Cluster 中的相对时间码为 int16，按时间码单位计算每个 Cluster 的块数
+func (m *webmMuxer) silence(d time.Duration) error {
+	if !m.started || m.track == 0 {
+		return errNoSegment
+	}
+
+	frame := m.frameTicks()
+	if frame == 0 {
+		return fmt.Errorf("%w: silence with timecode scale %dns", ErrUnsupported, m.scale)
+	}
+	maxBlocks := max(1, int(math.MaxInt16/frame))
+	n, err := silenceFrames(d, opusFrame)
+	if err != nil {
+		return err
+	}
+	for n > 0 {
+		blocks := min(n, maxBlocks)
+		body := ebmlEncode(ebmlTimecodeID, ebmlUintBytes(uint64(m.end)))
+		for i := 0; i < blocks; i++ {
+			block := ebmlVint(uint64(m.track))
+			block = binary.BigEndian.AppendUint16(block, uint16(int64(i)*frame))
+			block = append(block, 0x80) // 关键帧
+			block = append(block, opusSilence...)
+			body = append(body, ebmlEncode(ebmlSimpleBlock, block)...)
+		}
+		if _, err := m.w.Write(ebmlEncode(ebmlClusterID, body)); err != nil {
+			return err
+		}
+		m.end += int64(blocks) * frame
+		n -= blocks
+	}
+	return nil
+}
+
+func (m *webmMuxer) close() error {
+	return nil
+}
+
+// frameTicks 20ms 对应的时间码
+func (m *webmMuxer) frameTicks() int64 {
+	scale := m.scale
+	if scale <= 0 {
+		scale = 1_000_000
+	}
+	return int64(opusFrame) / scale
+}
+
+// ebmlClusterChildren Cluster 的子元素，长度未知的 Cluster 在遇到其他元素时结束
+var ebmlClusterChildren = map[uint32]bool{
+	ebmlTimecodeID: true, ebmlPositionID: true, ebmlPrevSizeID: true, ebmlSimpleBlock: true,
+	ebmlBlockGroupID: true, ebmlVoidID: true, 0x5854: true, 0xAF: true, // SilentTracks、EncryptedBlock
+}
+
+// parseEBML 解析同级的元素
+func parseEBML(data []byte) ([]ebmlElement, error) {
+	var elems []ebmlElement
+	for len(data) > 0 {
+		id, n := ebmlReadID(data)
+		if n == 0 {
+			return nil, errors.New("audio: invalid ebml element id")
+		}
+		size, m := ebmlReadVint(data[n:])
+		if m == 0 {
+			return nil, errors.New("audio: invalid ebml element size")
+		}
+		data = data[n+m:]
+
+		if size == ebmlUnknownSize {
+			size = ebmlUnknownEnd(data, id)
+		}
+		if size > int64(len(data)) {
+			// 流式生成的音频最后一个元素可能不完整
+			size = int64(len(data))
+		}
+		elems = append(elems, ebmlElement{id: id, data: data[:size]})
+		data = data[size:]
+	}
+	return elems, nil
+}
+
+// ebmlUnknownEnd 长度未知的元素的内容长度：Cluster 在遇到非 Cluster 子元素时结束，其他元素至数据结尾
+func ebmlUnknownEnd(data []byte, id uint32) int64 {
+	if id != ebmlClusterID {
+		return int64(len(data))
+	}
+
+	p := 0
+	for p < len(data) {
+		cid, n := ebmlReadID(data[p:])
+		if n == 0 || !ebmlClusterChildren[cid] {
+			break
+		}
+		size, m := ebmlReadVint(data[p+n:])
+		if m == 0 || size == ebmlUnknownSize || p+n+m+int(size) > len(data) {
+			return int64(len(data))
+		}
+		p += n + m + int(size)
+	}
+	return int64(p)
+}
+
+func findEBML(data []byte, id uint32) []byte {
+	elems, _ := parseEBML(data)
+	for _, e := range elems {
+		if e.id == id {
+			return e.data
+		}
+	}
+	return nil
+}
+
+func removeEBML(data []byte, id uint32) []byte {
+	elems, err := parseEBML(data)
+	if err != nil {
+		return data
+	}
+	var out []byte
+	for _, e := range elems {
+		if e.id != id {
+			out = append(out, ebmlEncode(e.id, e.data)...)
+		}
+	}
+	return out
+}
+
+// infoScale Info 中的 TimecodeScale，未设置时返回 0，即默认的 1ms
+func infoScale(info []byte) int64 {
+	if v := findEBML(info, ebmlScaleID); v != nil {
+		return int64(ebmlUint(v))
+	}
+	return 0
+}
+
+// parseBlock 解析 SimpleBlock 及 Block 的音轨号及相对时间码
+func parseBlock(b []byte) (track, rel int64, ok bool) {
+	v, n := ebmlReadVint(b)
+	if n == 0 || len(b) < n+2 {
+		return 0, 0, false
+	}
+	return v, int64(int16(binary.BigEndian.Uint16(b[n:]))), true
+}
+
+// ebmlReadID 读取元素 ID，ID 保留长度标记位
+func ebmlReadID(b []byte) (uint32, int) {
+	if len(b) == 0 || b[0] == 0 {
+		return 0, 0
+	}
+	n := 1
+	for mask := byte(0x80); b[0]&mask == 0; mask >>= 1 {
+		n++
+	}
+	if n > 4 || len(b) < n {
+		return 0, 0
+	}
+	var id uint32
+	for _, v := range b[:n] {
+		id = id<<8 | uint32(v)
+	}
+	return id, n
+}
+
+// ebmlReadVint 读取变长整数，所有数值位均为 1 时为 ebmlUnknownSize
+func ebmlReadVint(b []byte) (int64, int) {
+	if len(b) == 0 || b[0] == 0 {
+		return 0, 0
+	}
+	n := 1
+	for mask := byte(0x80); b[0]&mask == 0; mask >>= 1 {
+		n++
+	}
+	if len(b) < n {
+		return 0, 0
+	}
+	v := uint64(b[0] & (0xFF >> n))
+	unknown := v == uint64(0xFF>>n)
+	for _, c := range b[1:n] {
+		v = v<<8 | uint64(c)
+		unknown = unknown && c == 0xFF
+	}
+	if unknown {
+		return ebmlUnknownSize, n
+	}
+	return int64(v), n
+}
+
+// ebmlVint 编码变长整数，使用最短的长度
+func ebmlVint(v uint64) []byte {
+	n := 1
+	for v >= 1<<(7*n)-1 && n < 8 {
+		n++
+	}
+	b := make([]byte, n)
+	for i := n - 1; i >= 0; i-- {
+		b[i] = byte(v)
+		v >>= 8
+	}
+	b[0] |= 0x80 >> (n - 1)
+	return b
+}
+
+func ebmlEncode(id uint32, data []byte) []byte {
+	var b []byte
+	for shift := 24; shift >= 0; shift -= 8 {
+		if v := byte(id >> shift); v != 0 || len(b) > 0 {
+			b = append(b, v)
+		}
+	}
+	b = append(b, ebmlVint(uint64(len(data)))...)
+	return append(b, data...)
+}
+
+func ebmlUint(b []byte) uint64 {
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
+
+func ebmlUintBytes(v uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, v)
+}
@@ -0,0 +1,127 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+)
+
+func simpleBlock(rel int16, payload byte) []byte {
+	b := []byte{0x81}
+	b = binary.BigEndian.AppendUint16(b, uint16(rel))
+	return ebmlEncode(ebmlSimpleBlock, append(b, 0x80, payload))
+}
+
+// newWebM 流式生成的 WebM：Segment 及 Cluster 的长度均未知，包含 3 个间隔 20ms 的块
+func newWebM(payload byte) []byte {
+	return newWebMScale(payload, time.Millisecond)
+}
+
+// newWebMScale 时间码单位为 scale 的 WebM
+func newWebMScale(payload byte, scale time.Duration) []byte {
+	var b []byte
+	b = append(b, ebmlEncode(ebmlHeaderID, ebmlEncode(0x4282, []byte("webm")))...)
+	b = append(b, 0x18, 0x53, 0x80, 0x67, 0x01, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF)
+	info := append(ebmlEncode(ebmlScaleID, ebmlUintBytes(uint64(scale))), ebmlEncode(ebmlDurationID, []byte{0x42, 0x70, 0, 0})...)
+	b = append(b, ebmlEncode(ebmlInfoID, info)...)
+	b = append(b, ebmlEncode(ebmlTracksID, []byte{0xAE, 0x81, 0xD7, 0x81, 0x01})...)
+	b = append(b, 0x1F, 0x43, 0xB6, 0x75, 0x01, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF)
+	b = append(b, ebmlEncode(ebmlTimecodeID, []byte{0})...)
+	step := int(20 * time.Millisecond / scale)
+	for i := 0; i < 3; i++ {
+		b = append(b, simpleBlock(int16(i*step), payload)...)
+	}
+	return b
+}
+
+func TestConcatWebM(t *testing.T) {
+	out, err := Concat(mustFormat(t, "webm-24khz-16bit-mono-opus"), [][]byte{newWebM(1), newWebM(2)}, 100*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	elems, err := parseEBML(out)
+	if err != nil || len(elems) != 2 || elems[0].id != ebmlHeaderID || elems[1].id != ebmlSegmentID {
+		t.Fatalf("elems = %v, err = %v", elems, err)
+	}
+	children, err := parseEBML(elems[1].data)
+	if err != nil || len(children) != 5 {
+		t.Fatalf("segment children = %d, err = %v", len(children), err)
+	}
+	if children[0].id != ebmlInfoID || findEBML(children[0].data, ebmlDurationID) != nil {
+		t.Error("Info 中的时长应去掉")
+	}
+	if children[1].id != ebmlTracksID {
+		t.Error("应保留 Tracks")
+	}
+
+	// 第一段结束于 60ms，静音 100ms，第二段从 160ms 开始
+	wantTimecodes := []uint64{0, 60, 160}
+	wantBlocks := []int{3, 5, 3}
+	for i, c := range children[2:] {
+		if c.id != ebmlClusterID {
+			t.Fatalf("第 %d 个元素不是 Cluster", i+2)
+		}
+		blocks, _ := parseEBML(c.data)
+		if tc := ebmlUint(findEBML(c.data, ebmlTimecodeID)); tc != wantTimecodes[i] || len(blocks)-1 != wantBlocks[i] {
+			t.Errorf("第 %d 个 Cluster timecode = %d, blocks = %d", i, tc, len(blocks)-1)
+		}
+	}
+	if !bytes.Contains(children[3].data, opusSilence) {
+		t.Error("静音 Cluster 应为 Opus 静音包")
+	}
+}
+
+func TestWebMSilenceScale(t *testing.T) {
+	// 时间码单位为 100µs 时 20ms 为 200，每个 Cluster 最多 163 个块
+	f := mustFormat(t, "webm-24khz-16bit-mono-opus")
+	out, err := Concat(f, [][]byte{newWebMScale(1, 100*time.Microsecond), newWebMScale(2, 100*time.Microsecond)}, 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	elems, _ := parseEBML(out)
+	children, err := parseEBML(elems[1].data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var next uint64 = 600 // 第一段结束于 60ms
+	silence := 0
+	for _, c := range children[3 : len(children)-1] {
+		tc := ebmlUint(findEBML(c.data, ebmlTimecodeID))
+		if tc != next {
+			t.Fatalf("静音 Cluster timecode = %d, 期望 %d", tc, next)
+		}
+		blocks, _ := parseEBML(c.data)
+		for i, b := range blocks[1:] {
+			_, rel, ok := parseBlock(b.data)
+			if !ok || rel != int64(i*200) {
+				t.Fatalf("第 %d 个块相对时间码 = %d", i, rel)
+			}
+		}
+		next += uint64(len(blocks)-1) * 200
+		silence += len(blocks) - 1
+	}
+	if silence != 250 || ebmlUint(findEBML(children[len(children)-1].data, ebmlTimecodeID)) != next {
+		t.Errorf("静音块 = %d", silence)
+	}
+
+	// 时间码单位大于 20ms 时无法插入静音
+	if _, err := Concat(f, [][]byte{newWebMScale(1, 50*time.Millisecond), newWebMScale(2, 50*time.Millisecond)}, time.Second); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestEBMLVint(t *testing.T) {
+	for _, v := range []uint64{0, 1, 126, 127, 16382, 16383, 1 << 40} {
+		got, n := ebmlReadVint(ebmlVint(v))
+		if got != int64(v) || n != len(ebmlVint(v)) {
+			t.Errorf("vint(%d) = %d", v, got)
+		}
+	}
+	if v, n := ebmlReadVint([]byte{0xFF}); v != ebmlUnknownSize || n != 1 {
+		t.Errorf("unknown size = %d", v)
+	}
+}
@@ -0,0 +1,144 @@
+package audio
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ErrFormatMismatch 拼接的各段音频参数不一致，如采样率不同
+var ErrFormatMismatch = errors.New("audio: segment format mismatch")
+
+// errNoSegment 容器格式的静音需要参照第一段音频的参数
+var errNoSegment = errors.New("audio: silence before the first segment")
+
+// ErrSilenceTooShort 静音时长不足半帧，以编码帧为单位的格式无法表示，参见 Format.SilenceFrame
+var ErrSilenceTooShort = errors.New("audio: silence shorter than half a frame")
+
+// muxer 各容器格式的拼接实现
+type muxer interface {
+	segment(data []byte, first bool) error
+	silence(d time.Duration) error
+	close() error
+}
+
+// Writer 将多段音频按顺序拼接为一个完整的音频写入 w
+//
+//	aw, err := audio.NewWriter(file, format)
+//	aw.WriteSegment(seg1)
+//	aw.WriteSilence(500 * time.Millisecond)
+//	aw.WriteSegment(seg2)
+//	err = aw.Close()
+//
+// riff 格式写入时数据长度未知，文件头中的长度为 0xFFFFFFFF；w 实现了 io.WriterAt（如 *os.File）时，
+// Close 会在偏移 0 处回填实际长度，因此 w 须从起始位置开始写入
+type Writer struct {
+	m      muxer
+	n      int
+	closed bool
+}
+
+// NewWriter 创建 Writer，不支持的格式返回 ErrUnsupported
+func NewWriter(w io.Writer, format Format) (*Writer, error) {
+	var m muxer
+	switch format.Container {
+	case ContainerRaw:
+		m = &rawMuxer{w: w, format: format}
+	case ContainerAMR:
+		m = &amrMuxer{w: w}
+	case ContainerRIFF:
+		m = &riffMuxer{w: w, format: format}
+	case ContainerMP3:
+		m = &mp3Muxer{w: w}
+	case ContainerOgg:
+		m = &oggMuxer{w: w}
+	case ContainerWebM:
+		m = &webmMuxer{w: w}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupported, format.Name)
+	}
+	return &Writer{m: m}, nil
+}
+
+// WriteSegment 追加一段完整的音频，如一次合成请求返回的音频
+func (w *Writer) WriteSegment(data []byte) error {
+	if w.closed {
+		return errors.New("audio: write to closed writer")
+	}
+	err := w.m.segment(data, w.n == 0)
+	w.n++
+	return err
+}
+
+// WriteSilence 追加指定时长的静音
+// mp3、ogg、webm、amr 以编码帧（ogg、webm、amr 为 20ms）为单位，时长按帧四舍五入，不足半帧时返回 ErrSilenceTooShort；
+// raw、riff 以采样为单位
+func (w *Writer) WriteSilence(d time.Duration) error {
+	if w.closed {
+		return errors.New("audio: write to closed writer")
+	}
+	if d <= 0 {
+		return nil
+	}
+	return w.m.silence(d)
+}
+
+// Close 写入剩余的数据并回填文件头，不会关闭底层的 io.Writer
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	return w.m.close()
+}
+
+// Concat 拼接多段音频，gap 大于 0 时在各段之间插入静音
+func Concat(format Format, segments [][]byte, gap time.Duration) ([]byte, error) {
+	buf := &writerAtBuffer{}
+	w, err := NewWriter(buf, format)
+	if err != nil {
+		return nil, err
+	}
+	for i, seg := range segments {
+		if i > 0 {
+			if err := w.WriteSilence(gap); err != nil {
+				return nil, err
+			}
+		}
+		if err := w.WriteSegment(seg); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writerAtBuffer 支持 WriteAt 的 bytes.Buffer，用于回填 riff 文件头
+type writerAtBuffer struct {
+	bytes.Buffer
+}
+
+func (b *writerAtBuffer) WriteAt(p []byte, off int64) (int, error) {
+	if off < 0 || int(off)+len(p) > b.Len() {
+		return 0, errors.New("audio: write out of range")
+	}
+	return copy(b.Bytes()[off:], p), nil
+}
+
+// silenceFrames 静音时长按 frame 四舍五入后的帧数
+func silenceFrames(d, frame time.Duration) (int, error) {
+	n := int((d + frame/2) / frame)
+	if n == 0 {
+		return 0, fmt.Errorf("%w: %s, frame %s", ErrSilenceTooShort, d, frame)
+	}
+	return n, nil
+}
+
+// samples 时长对应的采样数
+func samples(d time.Duration, rate int) int {
+	return int(d * time.Duration(rate) / time.Second)
+}
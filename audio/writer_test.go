@@ -0,0 +1,132 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+)
+
+func mustFormat(t *testing.T, name string) Format {
+	t.Helper()
+	f, err := ParseFormat(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func TestConcatRaw(t *testing.T) {
+	pcm := mustFormat(t, "raw-8khz-16bit-mono-pcm")
+	out, err := Concat(pcm, [][]byte{{1, 2}, {3, 4}}, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := append(append([]byte{1, 2}, make([]byte, 160)...), 3, 4)
+	if !bytes.Equal(out, want) {
+		t.Errorf("pcm = %v", out)
+	}
+
+	alaw := mustFormat(t, "raw-8khz-8bit-mono-alaw")
+	out, _ = Concat(alaw, [][]byte{{1}, {2}}, time.Millisecond)
+	if !bytes.Equal(out, []byte{1, 0xD5, 0xD5, 0xD5, 0xD5, 0xD5, 0xD5, 0xD5, 0xD5, 2}) {
+		t.Errorf("alaw = %v", out)
+	}
+
+	silk := mustFormat(t, "raw-16khz-16bit-mono-truesilk")
+	if _, err := Concat(silk, [][]byte{{1}, {2}}, time.Second); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("truesilk 静音 err = %v", err)
+	}
+	if out, _ := Concat(silk, [][]byte{{1}, {2}}, 0); !bytes.Equal(out, []byte{1, 2}) {
+		t.Errorf("truesilk = %v", out)
+	}
+}
+
+func TestConcatAMR(t *testing.T) {
+	out, err := Concat(mustFormat(t, "amr-wb-16000hz"), [][]byte{[]byte(amrWbHeader + "a"), []byte(amrWbHeader + "b")}, 40*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != amrWbHeader+"a\x7c\x7cb" {
+		t.Errorf("amr = %q", out)
+	}
+}
+
+func newWav(f Format, pcm []byte) []byte {
+	return append(wavHeader(wavFormatChunk(f), int64(len(pcm))), pcm...)
+}
+
+func TestConcatRIFF(t *testing.T) {
+	f := mustFormat(t, "riff-8khz-16bit-mono-pcm")
+	out, err := Concat(f, [][]byte{newWav(f, []byte{1, 2, 3, 4}), newWav(f, []byte{5, 6})}, 5*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fmtData, pcm, err := parseWav(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := append(append([]byte{1, 2, 3, 4}, make([]byte, 80)...), 5, 6)
+	if !bytes.Equal(pcm, want) || !bytes.Equal(fmtData, wavFormatChunk(f)) {
+		t.Errorf("pcm = %v", pcm)
+	}
+	if size := binary.LittleEndian.Uint32(out[4:]); int(size) != len(out)-8 {
+		t.Errorf("RIFF 长度 = %d, 文件长度 = %d", size, len(out))
+	}
+	if size := binary.LittleEndian.Uint32(out[40:]); int(size) != len(want) {
+		t.Errorf("data 长度 = %d", size)
+	}
+
+	// 不支持 WriteAt 时长度为 0xFFFFFFFF
+	buf := &bytes.Buffer{}
+	w, _ := NewWriter(buf, f)
+	_ = w.WriteSegment(newWav(f, []byte{1, 2}))
+	_ = w.Close()
+	if size := binary.LittleEndian.Uint32(buf.Bytes()[40:]); size != riffUnknownSize || buf.Len() != 46 {
+		t.Errorf("流式写入 data 长度 = %x", size)
+	}
+
+	// 采样率不同
+	other := mustFormat(t, "riff-16khz-16bit-mono-pcm")
+	if _, err := Concat(f, [][]byte{newWav(f, []byte{1, 2}), newWav(other, []byte{1, 2})}, 0); !errors.Is(err, ErrFormatMismatch) {
+		t.Errorf("err = %v", err)
+	}
+	if _, err := Concat(f, [][]byte{[]byte("not a wav")}, 0); err == nil {
+		t.Error("格式错误时应返回错误")
+	}
+}
+
+func TestWriterSilenceRounding(t *testing.T) {
+	amr := mustFormat(t, "amr-wb-16000hz")
+	seg := []byte(amrWbHeader)
+	for d, want := range map[time.Duration]int{10 * time.Millisecond: 1, 29 * time.Millisecond: 1, 30 * time.Millisecond: 2, 50 * time.Millisecond: 3} {
+		out, err := Concat(amr, [][]byte{seg, seg}, d)
+		if err != nil || len(out) != len(amrWbHeader)+want {
+			t.Errorf("%s: 静音帧数 = %d, err = %v", d, len(out)-len(amrWbHeader), err)
+		}
+	}
+
+	// 不足半帧的静音无法表示
+	for _, f := range []Format{amr, mustFormat(t, "ogg-24khz-16bit-mono-opus")} {
+		segs := [][]byte{seg, seg}
+		if f.Container == ContainerOgg {
+			segs = [][]byte{newOgg(1), newOgg(2)}
+		}
+		if _, err := Concat(f, segs, 5*time.Millisecond); !errors.Is(err, ErrSilenceTooShort) {
+			t.Errorf("%s err = %v", f.Name, err)
+		}
+	}
+}
+
+func TestWriterSilenceFirst(t *testing.T) {
+	w, _ := NewWriter(&bytes.Buffer{}, mustFormat(t, "audio-24khz-48kbitrate-mono-mp3"))
+	if err := w.WriteSilence(time.Second); !errors.Is(err, errNoSegment) {
+		t.Errorf("err = %v", err)
+	}
+	_ = w.Close()
+	if err := w.WriteSegment(nil); err == nil {
+		t.Error("关闭后写入应返回错误")
+	}
+}
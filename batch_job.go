@@ -0,0 +1,163 @@
+package go_micro_tts
+
+import (
+	"context"
+	"errors"
+	"github.com/xuemingjings/go-micro-tts/internal"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// 批处理合成任务的状态
+const (
+	BatchStatusNotStarted = "NotStarted"
+	BatchStatusRunning    = "Running"
+	BatchStatusSucceeded  = "Succeeded"
+	BatchStatusFailed     = "Failed"
+)
+
+const (
+	defaultBatchPollInterval    = 5 * time.Second
+	defaultBatchPollMaxInterval = time.Minute
+)
+
+// BatchJob 批处理合成任务
+type BatchJob struct {
+	Id string
+
+	g        *GoTTS
+	location string // 创建任务时返回的 Operation-Location，为空时按 Id 拼接地址
+}
+
+// BatchWaitOptions Wait 的轮询参数
+type BatchWaitOptions struct {
+	Interval    time.Duration                         // 首次轮询间隔，默认 5 秒，之后每次增加一半
+	MaxInterval time.Duration                         // 最大轮询间隔，默认 1 分钟
+	OnStatus    func(status *LongTextToVoiceGetIdRep) // 任务状态变化时回调，首次查询时也会回调
+}
+
+// BatchJobError 批处理合成任务失败，Code 与 Message 来自任务的 properties.error
+// 可通过 errors.Is(err, ErrBatchFailed) 判断
+type BatchJobError struct {
+	Id      string
+	Code    string
+	Message string
+}
+
+func (e *BatchJobError) Error() string {
+	msg := "batch synthesis " + e.Id + " failed"
+	if e.Code != "" {
+		msg += ": " + e.Code
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+func (e *BatchJobError) Unwrap() error {
+	return ErrBatchFailed
+}
+
+// NewBatchJob 由任务 ID 创建批处理合成任务
+func (g *GoTTS) NewBatchJob(id string) *BatchJob {
+	return &BatchJob{Id: id, g: g}
+}
+
+// CreateBatchJob 创建批处理合成任务，可通过 Wait 等待任务完成
+func (g *GoTTS) CreateBatchJob(ctx context.Context, longSpeak *LongSpeak) (*BatchJob, error) {
+	res, header, err := g.longTextToVoiceCreate(ctx, longSpeak)
+	if err != nil {
+		return nil, err
+	}
+
+	job := g.NewBatchJob(res.Id)
+	// 只接受批处理服务地址下的 Operation-Location，避免将 SPEECH_KEY 发送到其他地址
+	if loc := header.Get("Operation-Location"); strings.HasPrefix(loc, g.batchEndpoint+"/") {
+		job.location = loc
+	}
+	return job, nil
+}
+
+// WaitForCompletion 等待批处理合成任务完成，参见 BatchJob.Wait
+func (g *GoTTS) WaitForCompletion(ctx context.Context, id string, opts *BatchWaitOptions) (*LongTextToVoiceGetIdRep, error) {
+	return g.NewBatchJob(id).Wait(ctx, opts)
+}
+
+// Status 查询任务当前状态
+func (j *BatchJob) Status(ctx context.Context) (*LongTextToVoiceGetIdRep, error) {
+	res, _, err := j.status(ctx)
+	return res, err
+}
+
+// Delete 删除任务
+func (j *BatchJob) Delete(ctx context.Context) error {
+	_, err := j.g.LongTextToVoiceDelContext(ctx, j.Id)
+	return err
+}
+
+// Wait 轮询任务直到成功或失败
+// 成功时返回任务详情；失败时返回 *BatchJobError；ctx 取消时返回 ctx.Err()
+// 服务返回 Retry-After 时按其等待，否则按 opts 的间隔递增轮询
+func (j *BatchJob) Wait(ctx context.Context, opts *BatchWaitOptions) (*LongTextToVoiceGetIdRep, error) {
+	if opts == nil {
+		opts = &BatchWaitOptions{}
+	}
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = defaultBatchPollInterval
+	}
+	maxInterval := opts.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultBatchPollMaxInterval
+	}
+
+	lastStatus := ""
+	for {
+		res, header, err := j.status(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if res.Status != lastStatus {
+			lastStatus = res.Status
+			if opts.OnStatus != nil {
+				opts.OnStatus(res)
+			}
+		}
+
+		switch res.Status {
+		case BatchStatusSucceeded:
+			return res, nil
+		case BatchStatusFailed:
+			return nil, &BatchJobError{
+				Id:      j.Id,
+				Code:    res.Properties.Error.Code,
+				Message: res.Properties.Error.Message,
+			}
+		}
+
+		delay := interval
+		if d := retryAfter(header); d > 0 {
+			delay = d
+		}
+		interval = min(interval+interval/2, maxInterval)
+
+		if err := internal.Sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (j *BatchJob) status(ctx context.Context) (*LongTextToVoiceGetIdRep, http.Header, error) {
+	if j.Id == "" {
+		return nil, nil, errors.New("batch synthesis id is required")
+	}
+
+	uri := j.location
+	if uri == "" {
+		uri = j.g.batchEndpoint + apiLongTextToVoice + "/" + j.Id
+	}
+	return j.g.longTextToVoiceId(ctx, uri)
+}
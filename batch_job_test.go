@@ -0,0 +1,113 @@
+package go_micro_tts
+
+import (
+	"context"
+	"errors"
+	"github.com/xuemingjings/go-micro-tts/ttstest"
+	"testing"
+	"time"
+)
+
+func newTestLongSpeak() *LongSpeak {
+	return NewLongSpeak(&LongSpeakXmlReq{
+		DisplayName:          "批处理任务",
+		Inputs:               []*LongSpeakInputs{{Text: "中华兴盛，幸有斌哥"}},
+		OutputFormat:         Audio24kHz48KbitrateMonoMp3,
+		SynthesisConfigVoice: "zh-CN-YunxiNeural",
+	})
+}
+
+func TestBatchJobWait(t *testing.T) {
+	tts, srv := newTestTTS(t)
+
+	job, err := tts.CreateBatchJob(context.TODO(), newTestLongSpeak())
+	if err != nil {
+		t.Fatalf("创建任务报错 err:%v", err)
+	}
+	if job.location != srv.URL+ttstest.PathBatch+"/"+job.Id {
+		t.Errorf("location = %q", job.location)
+	}
+
+	var statuses []string
+	res, err := job.Wait(context.TODO(), &BatchWaitOptions{
+		Interval: time.Millisecond,
+		OnStatus: func(status *LongTextToVoiceGetIdRep) {
+			statuses = append(statuses, status.Status)
+		},
+	})
+	if err != nil {
+		t.Fatalf("等待任务报错 err:%v", err)
+	}
+	if res.Status != BatchStatusSucceeded || res.Outputs.Result == "" {
+		t.Errorf("res = %+v", res)
+	}
+	if len(statuses) != 3 || statuses[0] != BatchStatusNotStarted || statuses[2] != BatchStatusSucceeded {
+		t.Errorf("statuses = %v", statuses)
+	}
+}
+
+func TestBatchJobWaitFailed(t *testing.T) {
+	tts, _ := newTestTTS(t, ttstest.WithBatchFailure("InvalidVoice", "The voice is not supported."))
+
+	created, err := tts.LongTextToVoiceCreate(newTestLongSpeak())
+	if err != nil {
+		t.Fatalf("创建任务报错 err:%v", err)
+	}
+
+	_, err = tts.WaitForCompletion(context.TODO(), created.Id, &BatchWaitOptions{Interval: time.Millisecond})
+	var jobErr *BatchJobError
+	if !errors.As(err, &jobErr) || !errors.Is(err, ErrBatchFailed) {
+		t.Fatalf("err = %v", err)
+	}
+	if jobErr.Id != created.Id || jobErr.Code != "InvalidVoice" || jobErr.Message != "The voice is not supported." {
+		t.Errorf("jobErr = %+v", jobErr)
+	}
+}
+
+func TestBatchJobWaitRetryAfter(t *testing.T) {
+	tts, srv := newTestTTS(t,
+		ttstest.WithBatchSteps(BatchStatusRunning, BatchStatusSucceeded),
+		ttstest.WithBatchRetryAfter(time.Second),
+	)
+
+	job, err := tts.CreateBatchJob(context.TODO(), newTestLongSpeak())
+	if err != nil {
+		t.Fatalf("创建任务报错 err:%v", err)
+	}
+
+	start := time.Now()
+	if _, err := job.Wait(context.TODO(), &BatchWaitOptions{Interval: time.Millisecond}); err != nil {
+		t.Fatalf("等待任务报错 err:%v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("未按 Retry-After 等待 elapsed:%v", elapsed)
+	}
+	if n := srv.Requests(ttstest.PathBatch); n != 3 {
+		t.Errorf("请求次数 = %d", n)
+	}
+}
+
+func TestBatchJobWaitCanceled(t *testing.T) {
+	tts, _ := newTestTTS(t, ttstest.WithBatchSteps(BatchStatusRunning, BatchStatusRunning, BatchStatusSucceeded))
+
+	job, err := tts.CreateBatchJob(context.TODO(), newTestLongSpeak())
+	if err != nil {
+		t.Fatalf("创建任务报错 err:%v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	_, err = job.Wait(ctx, &BatchWaitOptions{
+		Interval: time.Hour,
+		OnStatus: func(*LongTextToVoiceGetIdRep) { cancel() },
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v", err)
+	}
+
+	if err := job.Delete(context.TODO()); err != nil {
+		t.Errorf("删除任务报错 err:%v", err)
+	}
+	if _, err := job.Status(context.TODO()); !errors.Is(err, ErrNotFound) {
+		t.Errorf("删除后查询 err = %v", err)
+	}
+}
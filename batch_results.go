@@ -0,0 +1,444 @@
+package go_micro_tts
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/xuemingjings/go-micro-tts/internal"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ErrResultSize 结果文件的大小与 summary.json 中记录的不一致
+var ErrResultSize = errors.New("batch synthesis result size mismatch")
+
+// BatchFileKind 结果文件的类型
+type BatchFileKind int
+
+const (
+	BatchFileAudio            BatchFileKind = iota + 1 // 音频文件，如 0001.mp3
+	BatchFileWordBoundary                              // 字边界，如 0001.word.json
+	BatchFileSentenceBoundary                          // 句子边界，如 0001.sentence.json
+	BatchFileSummary                                   // summary.json
+)
+
+const batchSummaryName = "summary.json"
+
+// BatchSummary 结果中的 summary.json
+type BatchSummary struct {
+	JobId   string               `json:"jobID"`
+	Status  string               `json:"status"`
+	Results []BatchSummaryResult `json:"results"`
+}
+
+// BatchSummaryResult 每个音频文件的合成结果，ConcatenateResult 为 true 时只有一个
+type BatchSummaryResult struct {
+	Texts         []string `json:"texts"`
+	Status        string   `json:"status"`
+	AudioFileName string   `json:"audioFileName"`
+	Properties    struct {
+		AudioSize                int64  `json:"audioSize"`
+		DurationInTicks          int64  `json:"durationInTicks"`
+		Duration                 string `json:"duration"`
+		WordBoundaryFileName     string `json:"wordBoundaryFileName"`
+		SentenceBoundaryFileName string `json:"sentenceBoundaryFileName"`
+	} `json:"properties"`
+}
+
+// AudioDuration 音频时长
+func (r *BatchSummaryResult) AudioDuration() time.Duration {
+	return ticksToDuration(float64(r.Properties.DurationInTicks))
+}
+
+// BatchResultFile 结果中的单个文件
+type BatchResultFile struct {
+	Name   string              // 文件名，如 0001.mp3
+	Kind   BatchFileKind       // 文件类型
+	Result *BatchSummaryResult // 所属的合成结果，summary.json 为 nil
+	Size   int64               // 文件大小，未知时为 -1
+
+	src resultSource
+}
+
+// Open 打开文件，读取完毕时校验大小，不一致时返回 ErrResultSize
+func (f *BatchResultFile) Open() (io.ReadCloser, error) {
+	rc, err := f.src.open(f.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &sizeCheckReader{rc: rc, name: f.Name, want: f.Size}, nil
+}
+
+// BatchResults 批处理合成的结果，使用完毕后需调用 Close
+type BatchResults struct {
+	summary *BatchSummary
+	files   []*BatchResultFile
+	src     resultSource
+	format  SsmlOut // 任务的输出格式，用于补全音频文件的扩展名
+}
+
+// Summary 返回 summary.json 的内容
+func (r *BatchResults) Summary() *BatchSummary {
+	return r.summary
+}
+
+// Files 按合成结果的顺序遍历全部文件，每个结果依次为音频、字边界、句子边界，最后为 summary.json
+//
+//	it := results.Files()
+//	for it.Next() {
+//		f := it.File()
+//	}
+func (r *BatchResults) Files() *BatchFileIterator {
+	return &BatchFileIterator{files: r.files, index: -1}
+}
+
+// Close 释放下载的临时文件
+func (r *BatchResults) Close() error {
+	return r.src.close()
+}
+
+// BatchFileIterator 结果文件的迭代器
+type BatchFileIterator struct {
+	files []*BatchResultFile
+	index int
+}
+
+// Next 移动到下一个文件，没有更多文件时返回 false
+func (it *BatchFileIterator) Next() bool {
+	if it.index < len(it.files) {
+		it.index++
+	}
+	return it.index < len(it.files)
+}
+
+// File 当前文件
+func (it *BatchFileIterator) File() *BatchResultFile {
+	if it.index < 0 || it.index >= len(it.files) {
+		return nil
+	}
+	return it.files[it.index]
+}
+
+// OpenResults 下载任务的结果
+// 默认结果为 ZIP 压缩包，下载到临时文件后读取；DecompressOutputFiles 为 true 时结果为已解压的目录，按需下载单个文件
+func (j *BatchJob) OpenResults(ctx context.Context) (*BatchResults, error) {
+	res, err := j.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if res.Status != BatchStatusSucceeded || res.Outputs.Result == "" {
+		return nil, fmt.Errorf("batch synthesis %s has no results, status %s", j.Id, res.Status)
+	}
+
+	u, err := url.Parse(res.Outputs.Result)
+	if err != nil {
+		return nil, err
+	}
+
+	var src resultSource
+	if strings.HasSuffix(u.Path, ".zip") {
+		src, err = j.g.downloadZip(ctx, u.String())
+	} else {
+		src = &dirSource{g: j.g, ctx: ctx, base: u}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := newBatchResults(src)
+	if err != nil {
+		_ = src.close()
+		return nil, err
+	}
+	results.format = SsmlOut(res.Properties.OutputFormat)
+	return results, nil
+}
+
+// DownloadResults 下载任务的结果并解压到 dir，返回 summary.json 的内容
+// 结果文件保留其相对路径，不会写到 dir 之外；音频文件没有扩展名时按任务的输出格式补全；多个结果映射到同一路径时返回错误
+func (j *BatchJob) DownloadResults(ctx context.Context, dir string) (*BatchSummary, error) {
+	results, err := j.OpenResults(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer results.Close()
+
+	if err := results.saveAll(dir); err != nil {
+		return nil, err
+	}
+	return results.Summary(), nil
+}
+
+// saveAll 将全部结果文件写入 dir
+func (r *BatchResults) saveAll(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	seen := make(map[string]string)
+	it := r.Files()
+	for it.Next() {
+		f := it.File()
+		name, err := resultFilePath(f.Name)
+		if err != nil {
+			return err
+		}
+		if f.Kind == BatchFileAudio {
+			name = r.format.FileName(name)
+		}
+		if prev, ok := seen[name]; ok {
+			return fmt.Errorf("result files %q and %q both map to %s", prev, f.Name, name)
+		}
+		seen[name] = f.Name
+
+		if err := saveResultFile(f, filepath.Join(dir, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resultFilePath 将服务端返回的文件名转换为 dir 下的相对路径，去掉 ..、绝对路径等可能写到 dir 之外的部分
+func resultFilePath(name string) (string, error) {
+	clean := strings.TrimPrefix(path.Clean("/"+strings.ReplaceAll(name, `\`, "/")), "/")
+	if clean == "" || strings.ContainsRune(clean, ':') {
+		return "", fmt.Errorf("invalid result file name %q", name)
+	}
+	return filepath.FromSlash(clean), nil
+}
+
+func saveResultFile(f *BatchResultFile, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	_, err = internal.WriteFileAtomic(dst, 0o644, func(w io.Writer) (int64, error) {
+		return io.Copy(w, rc)
+	})
+	return err
+}
+
+// newBatchResults 读取 summary.json，按合成结果整理文件列表并校验 ZIP 中的文件大小
+func newBatchResults(src resultSource) (*BatchResults, error) {
+	rc, err := src.open(batchSummaryName)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	summary := &BatchSummary{}
+	if err := json.NewDecoder(rc).Decode(summary); err != nil {
+		return nil, fmt.Errorf("%s: %w", batchSummaryName, err)
+	}
+
+	r := &BatchResults{summary: summary, src: src}
+	for i := range summary.Results {
+		result := &summary.Results[i]
+		if result.AudioFileName == "" {
+			continue
+		}
+		for _, f := range []struct {
+			name string
+			kind BatchFileKind
+			size int64
+		}{
+			{result.AudioFileName, BatchFileAudio, result.Properties.AudioSize},
+			{result.Properties.WordBoundaryFileName, BatchFileWordBoundary, -1},
+			{result.Properties.SentenceBoundaryFileName, BatchFileSentenceBoundary, -1},
+		} {
+			if f.name == "" {
+				continue
+			}
+			if f.size <= 0 {
+				f.size = -1
+			}
+			if size, ok := src.size(f.name); ok {
+				if f.size >= 0 && size != f.size {
+					return nil, fmt.Errorf("%w: %s is %d bytes, summary says %d", ErrResultSize, f.name, size, f.size)
+				}
+				f.size = size
+			} else if src.listed() {
+				return nil, fmt.Errorf("batch synthesis result %s is missing", f.name)
+			}
+			r.files = append(r.files, &BatchResultFile{Name: f.name, Kind: f.kind, Result: result, Size: f.size, src: src})
+		}
+	}
+
+	size, ok := src.size(batchSummaryName)
+	if !ok {
+		size = -1
+	}
+	r.files = append(r.files, &BatchResultFile{Name: batchSummaryName, Kind: BatchFileSummary, Size: size, src: src})
+
+	return r, nil
+}
+
+// resultSource 结果文件的来源：ZIP 压缩包或已解压的目录
+type resultSource interface {
+	open(name string) (io.ReadCloser, error)
+	size(name string) (int64, bool) // 文件大小，未知时返回 false
+	listed() bool                   // 是否已知全部文件
+	close() error
+}
+
+// zipSource 下载到临时文件的 ZIP 压缩包
+type zipSource struct {
+	file  *os.File
+	zr    *zip.Reader
+	files map[string]*zip.File
+}
+
+func (g *GoTTS) downloadZip(ctx context.Context, uri string) (*zipSource, error) {
+	tmp, err := os.CreateTemp("", "go-micro-tts-*.zip")
+	if err != nil {
+		return nil, err
+	}
+	src := &zipSource{file: tmp}
+
+	n, err := g.downloadResult(ctx, uri, tmp)
+	if err == nil {
+		src.zr, err = zip.NewReader(tmp, n)
+	}
+	if err != nil {
+		_ = src.close()
+		return nil, err
+	}
+
+	src.files = make(map[string]*zip.File, len(src.zr.File))
+	for _, f := range src.zr.File {
+		src.files[f.Name] = f
+	}
+	return src, nil
+}
+
+func (s *zipSource) open(name string) (io.ReadCloser, error) {
+	f, ok := s.files[name]
+	if !ok {
+		return nil, fmt.Errorf("batch synthesis result %s is missing", name)
+	}
+	return f.Open()
+}
+
+func (s *zipSource) size(name string) (int64, bool) {
+	f, ok := s.files[name]
+	if !ok {
+		return 0, false
+	}
+	return int64(f.UncompressedSize64), true
+}
+
+func (s *zipSource) listed() bool {
+	return true
+}
+
+func (s *zipSource) close() error {
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	_ = os.Remove(s.file.Name())
+	s.file = nil
+	return err
+}
+
+// dirSource 已解压到存储容器的结果，文件地址为容器地址加文件名，保留 SAS 查询参数
+type dirSource struct {
+	g    *GoTTS
+	ctx  context.Context
+	base *url.URL
+}
+
+func (s *dirSource) open(name string) (io.ReadCloser, error) {
+	u := *s.base
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + name
+	u.RawPath = ""
+
+	resp, err := s.g.getResult(s.ctx, u.String())
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (s *dirSource) size(string) (int64, bool) {
+	return 0, false
+}
+
+func (s *dirSource) listed() bool {
+	return false
+}
+
+func (s *dirSource) close() error {
+	return nil
+}
+
+// downloadResult 下载结果文件写入 w，校验 Content-Length
+func (g *GoTTS) downloadResult(ctx context.Context, uri string, w io.Writer) (int64, error) {
+	resp, err := g.getResult(ctx, uri)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	n, err := io.Copy(w, resp.Body)
+	if err != nil {
+		return n, err
+	}
+	if resp.ContentLength >= 0 && n != resp.ContentLength {
+		return n, fmt.Errorf("%w: downloaded %d bytes, Content-Length %d", ErrResultSize, n, resp.ContentLength)
+	}
+	return n, nil
+}
+
+// getResult 请求结果地址，结果地址自带 SAS 签名，不发送 SPEECH_KEY
+// 等待响应头的超时时间为 batchTimeout，下载文件的时间由 ctx 控制
+func (g *GoTTS) getResult(ctx context.Context, uri string) (*http.Response, error) {
+	client := g.newHTTPClient(ctx, g.batchTimeout, internal.WithHeaderTimeout(g.batchTimeout))
+	resp, funcClose, err := client.SendRequest(http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer funcClose()
+		return nil, newAPIError(opDownloadResults, resp)
+	}
+	// 关闭响应体时同时释放请求的 ctx，funcClose 关闭的是原响应的 Body
+	r := *resp
+	r.Body = &audioStream{Reader: resp.Body, close: funcClose}
+	return &r, nil
+}
+
+// sizeCheckReader 读取完毕时校验文件大小
+type sizeCheckReader struct {
+	rc   io.ReadCloser
+	name string
+	want int64
+	n    int64
+}
+
+func (r *sizeCheckReader) Read(p []byte) (int, error) {
+	n, err := r.rc.Read(p)
+	r.n += int64(n)
+	if err == io.EOF && r.want >= 0 && r.n != r.want {
+		return n, fmt.Errorf("%w: %s is %d bytes, want %d", ErrResultSize, r.name, r.n, r.want)
+	}
+	return n, err
+}
+
+func (r *sizeCheckReader) Close() error {
+	return r.rc.Close()
+}
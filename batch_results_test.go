@@ -0,0 +1,226 @@
+package go_micro_tts
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func waitTestBatchJob(t *testing.T, tts *GoTTS, req *LongSpeakXmlReq) *BatchJob {
+	t.Helper()
+
+	job, err := tts.CreateBatchJob(context.TODO(), NewLongSpeak(req))
+	if err != nil {
+		t.Fatalf("创建任务报错 err:%v", err)
+	}
+	if _, err := job.Wait(context.TODO(), &BatchWaitOptions{Interval: time.Millisecond}); err != nil {
+		t.Fatalf("等待任务报错 err:%v", err)
+	}
+	return job
+}
+
+func TestBatchJobOpenResults(t *testing.T) {
+	tts, _ := newTestTTS(t)
+	job := waitTestBatchJob(t, tts, &LongSpeakXmlReq{
+		DisplayName:             "结果",
+		Inputs:                  []*LongSpeakInputs{{Text: "你好。"}, {Text: "Hello world."}},
+		OutputFormat:            Audio24kHz48KbitrateMonoMp3,
+		WordBoundaryEnabled:     true,
+		SentenceBoundaryEnabled: true,
+		SynthesisConfigVoice:    "zh-CN-YunxiNeural",
+	})
+
+	results, err := job.OpenResults(context.TODO())
+	if err != nil {
+		t.Fatalf("打开结果报错 err:%v", err)
+	}
+	defer results.Close()
+
+	summary := results.Summary()
+	if summary.JobId != job.Id || len(summary.Results) != 2 {
+		t.Fatalf("summary = %+v", summary)
+	}
+	if d := summary.Results[1].AudioDuration(); d != 550*time.Millisecond {
+		t.Errorf("AudioDuration() = %v", d)
+	}
+
+	var names []string
+	it := results.Files()
+	for it.Next() {
+		f := it.File()
+		names = append(names, f.Name)
+
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("打开 %s 报错 err:%v", f.Name, err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil || int64(len(data)) != f.Size {
+			t.Errorf("%s: %d bytes, Size %d, err:%v", f.Name, len(data), f.Size, err)
+		}
+		if f.Kind == BatchFileAudio && !strings.HasPrefix(string(data), string(Audio24kHz48KbitrateMonoMp3)) {
+			t.Errorf("%s: %q", f.Name, data)
+		}
+	}
+	want := "0001.mp3 0001.word.json 0001.sentence.json 0002.mp3 0002.word.json 0002.sentence.json summary.json"
+	if got := strings.Join(names, " "); got != want {
+		t.Errorf("files = %s", got)
+	}
+}
+
+func TestBatchJobDownloadResults(t *testing.T) {
+	cases := []struct {
+		name string
+		req  LongSpeakXmlReq
+		want []string
+	}{
+		{"zip", LongSpeakXmlReq{}, []string{"0001.mp3", "0002.mp3", "summary.json"}},
+		{"concatenate", LongSpeakXmlReq{ConcatenateResult: true}, []string{"0001.mp3", "summary.json"}},
+		{"decompress", LongSpeakXmlReq{DecompressOutputFiles: true, WordBoundaryEnabled: true}, []string{"0001.mp3", "0001.word.json", "0002.mp3", "0002.word.json", "summary.json"}},
+	}
+	for _, c := range cases {
+		tts, _ := newTestTTS(t)
+		req := c.req
+		req.DisplayName = c.name
+		req.Inputs = []*LongSpeakInputs{{Text: "你好。"}, {Text: "再见。"}}
+		req.OutputFormat = Audio24kHz48KbitrateMonoMp3
+		job := waitTestBatchJob(t, tts, &req)
+
+		dir := filepath.Join(t.TempDir(), "out")
+		summary, err := job.DownloadResults(context.TODO(), dir)
+		if err != nil {
+			t.Fatalf("%s: 下载结果报错 err:%v", c.name, err)
+		}
+		if summary.Status != BatchStatusSucceeded {
+			t.Errorf("%s: summary = %+v", c.name, summary)
+		}
+
+		entries, _ := os.ReadDir(dir)
+		var got []string
+		for _, e := range entries {
+			got = append(got, e.Name())
+		}
+		if strings.Join(got, " ") != strings.Join(c.want, " ") {
+			t.Errorf("%s: files = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+// memSource 内存中的结果文件
+type memSource map[string]string
+
+func (s memSource) open(name string) (io.ReadCloser, error) {
+	data, ok := s[name]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return io.NopCloser(strings.NewReader(data)), nil
+}
+
+func (s memSource) size(name string) (int64, bool) {
+	data, ok := s[name]
+	return int64(len(data)), ok
+}
+
+func (s memSource) listed() bool { return true }
+
+func (s memSource) close() error { return nil }
+
+func TestBatchResultsSizeMismatch(t *testing.T) {
+	src := memSource{
+		"summary.json": `{"jobID":"1","status":"Succeeded","results":[{"audioFileName":"0001.mp3","properties":{"audioSize":10}}]}`,
+		"0001.mp3":     "audio",
+	}
+	if _, err := newBatchResults(src); !errors.Is(err, ErrResultSize) {
+		t.Errorf("err = %v", err)
+	}
+
+	delete(src, "0001.mp3")
+	if _, err := newBatchResults(src); err == nil || !strings.Contains(err.Error(), "missing") {
+		t.Errorf("err = %v", err)
+	}
+
+	// 未知大小的来源在读取完毕时校验
+	f := &BatchResultFile{Name: "summary.json", Size: 1, src: src}
+	rc, err := f.Open()
+	if err != nil {
+		t.Fatalf("打开文件报错 err:%v", err)
+	}
+	defer rc.Close()
+	if _, err := io.ReadAll(rc); !errors.Is(err, ErrResultSize) {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestBatchResultsSaveNested(t *testing.T) {
+	src := memSource{
+		"summary.json":     `{"jobID":"1","status":"Succeeded","results":[{"audioFileName":"a/0001.mp3"},{"audioFileName":"b/0001.mp3"},{"audioFileName":"../../c/0002.mp3"}]}`,
+		"a/0001.mp3":       "a",
+		"b/0001.mp3":       "b",
+		"../../c/0002.mp3": "c",
+	}
+	results, err := newBatchResults(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	if err := results.saveAll(dir); err != nil {
+		t.Fatalf("保存结果报错 err:%v", err)
+	}
+	for name, want := range map[string]string{"a/0001.mp3": "a", "b/0001.mp3": "b", "c/0002.mp3": "c"} {
+		data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil || string(data) != want {
+			t.Errorf("%s = %q, err = %v", name, data, err)
+		}
+	}
+}
+
+func TestBatchResultsSaveExtension(t *testing.T) {
+	src := memSource{
+		"summary.json": `{"jobID":"1","status":"Succeeded","results":[{"audioFileName":"0001"},{"audioFileName":"0002.wav"}]}`,
+		"0001":         "a",
+		"0002.wav":     "b",
+	}
+	results, err := newBatchResults(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results.format = Audio24kHz48KbitrateMonoMp3
+
+	// 只为没有扩展名的音频文件补全扩展名
+	dir := t.TempDir()
+	if err := results.saveAll(dir); err != nil {
+		t.Fatalf("保存结果报错 err:%v", err)
+	}
+	for name, want := range map[string]string{"0001.mp3": "a", "0002.wav": "b"} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil || string(data) != want {
+			t.Errorf("%s = %q, err = %v", name, data, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, batchSummaryName)); err != nil {
+		t.Errorf("summary.json err:%v", err)
+	}
+}
+
+func TestBatchResultsSaveCollision(t *testing.T) {
+	src := memSource{
+		"summary.json": `{"jobID":"1","status":"Succeeded","results":[{"audioFileName":"a/0001.mp3"},{"audioFileName":"a\\0001.mp3"}]}`,
+		"a/0001.mp3":   "a",
+		`a\0001.mp3`:   "b",
+	}
+	results, err := newBatchResults(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := results.saveAll(t.TempDir()); err == nil {
+		t.Error("多个结果映射到同一路径时应报错")
+	}
+}
@@ -0,0 +1,119 @@
+package go_micro_tts
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+// BoundaryKind 边界类型
+type BoundaryKind string
+
+const (
+	BoundaryWord        BoundaryKind = "Word"        // 字边界
+	BoundarySentence    BoundaryKind = "Sentence"    // 句子边界
+	BoundaryPunctuation BoundaryKind = "Punctuation" // 标点边界，仅实时合成返回
+)
+
+// Boundary 字边界或句子边界，表示一段文本在音频中的位置
+type Boundary struct {
+	Kind       BoundaryKind
+	Text       string        // 文本
+	Offset     time.Duration // 在音频中的开始时间
+	Duration   time.Duration // 朗读时长
+	TextOffset int           // 在原文中的字符偏移，服务未返回时为 -1
+}
+
+// End 在音频中的结束时间
+func (b Boundary) End() time.Duration {
+	return b.Offset + b.Duration
+}
+
+// boundaryJson 边界文件中的一项，时间单位为 tick（100 纳秒）
+type boundaryJson struct {
+	Text        string  `json:"Text"`
+	AudioOffset float64 `json:"AudioOffset"`
+	Duration    float64 `json:"Duration"`
+	TextOffset  *int    `json:"TextOffset"`
+}
+
+// ticksToDuration tick（100 纳秒）转为 time.Duration
+func ticksToDuration(ticks float64) time.Duration {
+	return time.Duration(ticks * 100)
+}
+
+// ParseBoundaries 解析批处理结果中的 *.word.json 或 *.sentence.json
+func ParseBoundaries(r io.Reader, kind BoundaryKind) ([]Boundary, error) {
+	var items []boundaryJson
+	if err := json.NewDecoder(r).Decode(&items); err != nil {
+		return nil, fmt.Errorf("parse %s boundaries: %w", kind, err)
+	}
+
+	res := make([]Boundary, 0, len(items))
+	for _, v := range items {
+		b := Boundary{
+			Kind:       kind,
+			Text:       v.Text,
+			Offset:     ticksToDuration(v.AudioOffset),
+			Duration:   ticksToDuration(v.Duration),
+			TextOffset: -1,
+		}
+		if v.TextOffset != nil {
+			b.TextOffset = *v.TextOffset
+		}
+		res = append(res, b)
+	}
+	return res, nil
+}
+
+// BoundaryAt 返回 t 时刻正在朗读的边界下标，用于卡拉 OK 式的逐字高亮
+// t 位于两个边界之间时返回前一个，t 早于第一个边界时返回 -1，boundaries 需按 Offset 排序
+func BoundaryAt(boundaries []Boundary, t time.Duration) int {
+	return sort.Search(len(boundaries), func(i int) bool {
+		return boundaries[i].Offset > t
+	}) - 1
+}
+
+// Boundaries 解析字边界或句子边界文件，其他类型的文件返回错误
+func (f *BatchResultFile) Boundaries() ([]Boundary, error) {
+	var kind BoundaryKind
+	switch f.Kind {
+	case BatchFileWordBoundary:
+		kind = BoundaryWord
+	case BatchFileSentenceBoundary:
+		kind = BoundarySentence
+	default:
+		return nil, fmt.Errorf("%s is not a boundary file", f.Name)
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return ParseBoundaries(rc, kind)
+}
+
+// WordBoundaries 音频文件对应的字边界，未启用 WordBoundaryEnabled 时返回 nil
+func (f *BatchResultFile) WordBoundaries() ([]Boundary, error) {
+	if f.Result == nil || f.Result.Properties.WordBoundaryFileName == "" {
+		return nil, nil
+	}
+	return f.sibling(f.Result.Properties.WordBoundaryFileName, BatchFileWordBoundary).Boundaries()
+}
+
+// SentenceBoundaries 音频文件对应的句子边界，未启用 SentenceBoundaryEnabled 时返回 nil
+func (f *BatchResultFile) SentenceBoundaries() ([]Boundary, error) {
+	if f.Result == nil || f.Result.Properties.SentenceBoundaryFileName == "" {
+		return nil, nil
+	}
+	return f.sibling(f.Result.Properties.SentenceBoundaryFileName, BatchFileSentenceBoundary).Boundaries()
+}
+
+// sibling 同一合成结果中的其他文件
+func (f *BatchResultFile) sibling(name string, kind BatchFileKind) *BatchResultFile {
+	return &BatchResultFile{Name: name, Kind: kind, Result: f.Result, Size: -1, src: f.src}
+}
@@ -0,0 +1,96 @@
+package go_micro_tts
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseBoundaries(t *testing.T) {
+	data := `[
+  {"Text": "你", "AudioOffset": 500000, "Duration": 2500000, "TextOffset": 0},
+  {"Text": "好", "AudioOffset": 3000000, "Duration": 2500000.5}
+]`
+	got, err := ParseBoundaries(strings.NewReader(data), BoundaryWord)
+	if err != nil {
+		t.Fatalf("解析报错 err:%v", err)
+	}
+	want := []Boundary{
+		{Kind: BoundaryWord, Text: "你", Offset: 50 * time.Millisecond, Duration: 250 * time.Millisecond, TextOffset: 0},
+		{Kind: BoundaryWord, Text: "好", Offset: 300 * time.Millisecond, Duration: 250*time.Millisecond + 50, TextOffset: -1},
+	}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("got %+v", got)
+	}
+	if got[0].End() != 300*time.Millisecond {
+		t.Errorf("End() = %v", got[0].End())
+	}
+
+	for _, c := range []struct {
+		t    time.Duration
+		want int
+	}{
+		{0, -1},
+		{50 * time.Millisecond, 0},
+		{299 * time.Millisecond, 0},
+		{time.Second, 1},
+	} {
+		if i := BoundaryAt(got, c.t); i != c.want {
+			t.Errorf("BoundaryAt(%v) = %d, want %d", c.t, i, c.want)
+		}
+	}
+
+	if _, err := ParseBoundaries(strings.NewReader("{"), BoundarySentence); err == nil {
+		t.Error("无效的 JSON 应返回错误")
+	}
+}
+
+func TestBatchResultFileBoundaries(t *testing.T) {
+	tts, _ := newTestTTS(t)
+	job := waitTestBatchJob(t, tts, &LongSpeakXmlReq{
+		DisplayName:             "边界",
+		Inputs:                  []*LongSpeakInputs{{Text: "Hello world. 你好。"}},
+		OutputFormat:            Audio24kHz48KbitrateMonoMp3,
+		WordBoundaryEnabled:     true,
+		SentenceBoundaryEnabled: true,
+	})
+
+	results, err := job.OpenResults(context.TODO())
+	if err != nil {
+		t.Fatalf("打开结果报错 err:%v", err)
+	}
+	defer results.Close()
+
+	it := results.Files()
+	for it.Next() {
+		f := it.File()
+		if f.Kind != BatchFileAudio {
+			continue
+		}
+
+		words, err := f.WordBoundaries()
+		if err != nil {
+			t.Fatalf("字边界报错 err:%v", err)
+		}
+		var texts []string
+		for _, w := range words {
+			texts = append(texts, w.Text)
+		}
+		if strings.Join(texts, " ") != "Hello world 你 好" || words[1].Offset != 300*time.Millisecond {
+			t.Errorf("words = %+v", words)
+		}
+
+		sentences, err := f.SentenceBoundaries()
+		if err != nil {
+			t.Fatalf("句子边界报错 err:%v", err)
+		}
+		if len(sentences) != 2 || sentences[0].Text != "Hello world." || sentences[1].Offset != words[2].Offset || sentences[1].Kind != BoundarySentence {
+			t.Errorf("sentences = %+v", sentences)
+		}
+
+		if _, err := f.Boundaries(); err == nil {
+			t.Error("音频文件不是边界文件")
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package go_micro_tts
+
+import (
+	"context"
+	"github.com/xuemingjings/go-micro-tts/internal"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultTokenTimeout     = 5 * time.Second
+	defaultVoiceListTimeout = 5 * time.Second
+	defaultSynthesisTimeout = 60 * time.Second
+	defaultBatchTimeout     = 5 * time.Second
+)
+
+// WithHTTPClient 使用自定义的 http.Client 发送全部请求（令牌、语音列表、合成、批处理）
+// 其 Timeout 会被各接口的超时配置覆盖
+func WithHTTPClient(client *http.Client) Option {
+	return func(g *GoTTS) {
+		g.httpClient = client
+	}
+}
+
+// WithTransport 使用自定义的 http.RoundTripper 发送全部请求，可用于代理、mTLS、链路追踪或测试替身
+func WithTransport(transport http.RoundTripper) Option {
+	return func(g *GoTTS) {
+		g.transport = transport
+	}
+}
+
+// WithTokenTimeout 获取访问令牌的超时时间，默认 5 秒
+func WithTokenTimeout(timeout time.Duration) Option {
+	return func(g *GoTTS) {
+		g.tokenTimeout = timeout
+	}
+}
+
+// WithVoiceListTimeout 获取语音列表的超时时间，默认 5 秒
+func WithVoiceListTimeout(timeout time.Duration) Option {
+	return func(g *GoTTS) {
+		g.voiceListTimeout = timeout
+	}
+}
+
+// WithSynthesisTimeout 文本转语音等待响应头的超时时间，默认 60 秒
+// 音频以流的方式读取，读取音频数据的时间不受该超时限制，需要限制总时长时请使用 ctx
+func WithSynthesisTimeout(timeout time.Duration) Option {
+	return func(g *GoTTS) {
+		g.synthesisTimeout = timeout
+	}
+}
+
+// WithBatchTimeout 批处理合成（长语音）接口的超时时间，默认 5 秒
+func WithBatchTimeout(timeout time.Duration) Option {
+	return func(g *GoTTS) {
+		g.batchTimeout = timeout
+	}
+}
+
+// setHTTPClient 初始化所有请求共享的 http.Client
+func (g *GoTTS) setHTTPClient() {
+	if g.httpClient == nil {
+		g.httpClient = &http.Client{}
+	}
+	if g.transport != nil {
+		client := *g.httpClient
+		client.Transport = g.transport
+		g.httpClient = &client
+	}
+}
+
+// newHTTPClient 基于共享的 http.Client 创建单次请求的客户端
+func (g *GoTTS) newHTTPClient(ctx context.Context, timeout time.Duration, opts ...internal.Option) *internal.HTTPClient {
+	opts = append([]internal.Option{
+		internal.WithClient(g.httpClient),
+		internal.WithTimeout(timeout),
+	}, opts...)
+	if g.retryPolicy.MaxAttempts > 1 {
+		opts = append(opts, internal.WithRetry(g.retryPolicy.retry))
+	}
+	return internal.NewHTTPClient(ctx, opts...)
+}
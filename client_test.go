@@ -0,0 +1,50 @@
+package go_micro_tts
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestWithTransport(t *testing.T) {
+	var paths []string
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		paths = append(paths, req.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Status:     "200 OK",
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`[{"ShortName":"zh-CN-YunxiNeural"}]`)),
+			Request:    req,
+		}, nil
+	})
+
+	tts, err := NewGoTTS(
+		context.TODO(),
+		WithSpeechRegion("eastus"),
+		WithSpeechKey("key"),
+		WithTransport(transport),
+	)
+	if err != nil {
+		t.Fatalf("初始化报错 err:%v", err)
+	}
+
+	list, err := tts.GetVoiceList()
+	if err != nil {
+		t.Fatalf("获取VoiceList报错 err:%v", err)
+	}
+	if len(*list) != 1 || (*list)[0].ShortName != "zh-CN-YunxiNeural" {
+		t.Errorf("list = %+v", list)
+	}
+	if len(paths) != 1 || paths[0] != apiVoiceList {
+		t.Errorf("paths = %v", paths)
+	}
+}
@@ -0,0 +1,77 @@
+package go_micro_tts
+
+import (
+	"context"
+	"errors"
+	"github.com/xuemingjings/go-micro-tts/ttstest"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestContextCanceled(t *testing.T) {
+	tts, srv := newTestTTS(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := tts.GetVoiceListContext(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, 期望 context.Canceled", err)
+	}
+	if n := srv.Requests(""); n != 0 {
+		t.Errorf("请求次数 = %d, 期望 0", n)
+	}
+}
+
+func TestContextCancelStreaming(t *testing.T) {
+	tokenSrv := ttstest.NewServer()
+	defer tokenSrv.Close()
+
+	// 先返回部分音频，之后一直阻塞直到客户端断开
+	audioSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "audio/mpeg")
+		_, _ = w.Write([]byte("partial audio"))
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer audioSrv.Close()
+
+	tts, err := NewGoTTS(
+		context.TODO(),
+		WithSpeechKey(tokenSrv.SpeechKey),
+		WithEndpoint(audioSrv.URL),
+		WithTokenEndpoint(tokenSrv.URL),
+		WithBatchEndpoint(tokenSrv.URL),
+	)
+	if err != nil {
+		t.Fatalf("初始化报错 err:%v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ssml := NewSpeakXml(&SpeakXmlReq{Lang: "zh-CN", Name: "zh-CN-YunxiNeural", Text: "你好"})
+	resp, funcClose, err := tts.TextToVoiceContext(ctx, Audio16kHz32KbitrateMonoMp3, ssml)
+	defer funcClose()
+	if err != nil {
+		t.Fatalf("TextToVoiceContext报错 err:%v", err)
+	}
+
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := io.ReadAll(resp.Body)
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("err = %v, 期望 context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("取消 ctx 后读取响应体未中断")
+	}
+}
@@ -6,17 +6,22 @@ import (
 )
 
 type VoiceList struct {
-	Name            string `json:"Name"`
-	DisplayName     string `json:"DisplayName"`
-	LocalName       string `json:"LocalName"`
-	ShortName       string `json:"ShortName"`
-	Gender          string `json:"Gender"`
-	Locale          string `json:"Locale"`
-	LocaleName      string `json:"LocaleName"`
-	SampleRateHertz string `json:"SampleRateHertz"`
-	VoiceType       string `json:"VoiceType"`
-	Status          string `json:"Status"`
-	WordsPerMinute  string `json:"WordsPerMinute"`
+	Name                string              `json:"Name"`
+	DisplayName         string              `json:"DisplayName"`
+	LocalName           string              `json:"LocalName"`
+	ShortName           string              `json:"ShortName"`
+	Gender              string              `json:"Gender"`
+	Locale              string              `json:"Locale"`
+	LocaleName          string              `json:"LocaleName"`
+	StyleList           []string            `json:"StyleList,omitempty"` // 支持的说话风格
+	SampleRateHertz     string              `json:"SampleRateHertz"`
+	VoiceType           string              `json:"VoiceType"`
+	Status              string              `json:"Status"`
+	ExtendedPropertyMap map[string]any      `json:"ExtendedPropertyMap,omitempty"` // 扩展属性，如 IsHighQuality48K
+	RolePlayList        []string            `json:"RolePlayList,omitempty"`        // 支持的角色扮演
+	SecondaryLocaleList []string            `json:"SecondaryLocaleList,omitempty"` // 多语言语音支持的其他区域设置
+	VoiceTag            map[string][]string `json:"VoiceTag,omitempty"`            // 语音标签，如 TailoredScenarios、VoicePersonalities
+	WordsPerMinute      string              `json:"WordsPerMinute"`
 }
 
 type SpeakXml struct {
@@ -170,6 +175,14 @@ var (
 	Raw24kHz16BitMonoTruesilk     SsmlOut = "raw-24khz-16bit-mono-truesilk"
 	Raw44100Hz16BitMonoPcm        SsmlOut = "raw-44100hz-16bit-mono-pcm"
 	Raw48kHz16BitMonoPcm          SsmlOut = "raw-48khz-16bit-mono-pcm"
+	Riff8kHz8BitMonoAlaw          SsmlOut = "riff-8khz-8bit-mono-alaw"
+	Riff8kHz8BitMonoMulaw         SsmlOut = "riff-8khz-8bit-mono-mulaw"
+	Riff8kHz16BitMonoPcm          SsmlOut = "riff-8khz-16bit-mono-pcm"
+	Riff16kHz16BitMonoPcm         SsmlOut = "riff-16khz-16bit-mono-pcm"
+	Riff22050Hz16BitMonoPcm       SsmlOut = "riff-22050hz-16bit-mono-pcm"
+	Riff24kHz16BitMonoPcm         SsmlOut = "riff-24khz-16bit-mono-pcm"
+	Riff44100Hz16BitMonoPcm       SsmlOut = "riff-44100hz-16bit-mono-pcm"
+	Riff48kHz16BitMonoPcm         SsmlOut = "riff-48khz-16bit-mono-pcm"
 	Webm16kHz16BitMonoOpus        SsmlOut = "webm-16khz-16bit-mono-opus"
 	Webm24kHz16Bit24kbpsMonoOpus  SsmlOut = "webm-24khz-16bit-24kbps-mono-opus"
 	Webm24kHz16BitMonoOpus        SsmlOut = "webm-24khz-16bit-mono-opus"
@@ -0,0 +1,51 @@
+package go_micro_tts
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	defaultEndpoint      = "https://%s.tts.speech.microsoft.com"
+	defaultTokenEndpoint = "https://%s.api.cognitive.microsoft.com"
+	defaultBatchEndpoint = "https://%s.customvoice.api.speech.microsoft.com"
+)
+
+// WithEndpoint 自定义语音合成及语音列表接口的服务地址
+// 例如 Azure 中国区 https://chinaeast2.tts.speech.azure.cn 或私有终结点的自定义域名
+func WithEndpoint(endpoint string) Option {
+	return func(g *GoTTS) {
+		g.endpoint = endpoint
+	}
+}
+
+// WithTokenEndpoint 自定义访问令牌接口的服务地址
+func WithTokenEndpoint(endpoint string) Option {
+	return func(g *GoTTS) {
+		g.tokenEndpoint = endpoint
+	}
+}
+
+// WithBatchEndpoint 自定义批处理合成（长语音）接口的服务地址
+func WithBatchEndpoint(endpoint string) Option {
+	return func(g *GoTTS) {
+		g.batchEndpoint = endpoint
+	}
+}
+
+// setEndpoints 未自定义的服务地址按区域生成默认值
+func (g *GoTTS) setEndpoints() {
+	if g.endpoint == "" {
+		g.endpoint = fmt.Sprintf(defaultEndpoint, g.speechRegion)
+	}
+	if g.tokenEndpoint == "" {
+		g.tokenEndpoint = fmt.Sprintf(defaultTokenEndpoint, g.speechRegion)
+	}
+	if g.batchEndpoint == "" {
+		g.batchEndpoint = fmt.Sprintf(defaultBatchEndpoint, g.speechRegion)
+	}
+
+	g.endpoint = strings.TrimRight(g.endpoint, "/")
+	g.tokenEndpoint = strings.TrimRight(g.tokenEndpoint, "/")
+	g.batchEndpoint = strings.TrimRight(g.batchEndpoint, "/")
+}
@@ -0,0 +1,50 @@
+package go_micro_tts
+
+import (
+	"context"
+	"testing"
+)
+
+func TestEndpoints(t *testing.T) {
+	tts, err := NewGoTTS(
+		context.TODO(),
+		WithSpeechRegion("eastus"),
+		WithSpeechKey("key"),
+		WithEndpoint("https://eastus.tts.speech.azure.cn/"),
+	)
+	if err != nil {
+		t.Fatalf("初始化报错 err:%v", err)
+	}
+
+	if tts.endpoint != "https://eastus.tts.speech.azure.cn" {
+		t.Errorf("endpoint = %s", tts.endpoint)
+	}
+	if tts.tokenEndpoint != "https://eastus.api.cognitive.microsoft.com" {
+		t.Errorf("tokenEndpoint = %s", tts.tokenEndpoint)
+	}
+	if tts.batchEndpoint != "https://eastus.customvoice.api.speech.microsoft.com" {
+		t.Errorf("batchEndpoint = %s", tts.batchEndpoint)
+	}
+}
+
+func TestEndpointsWithoutRegion(t *testing.T) {
+	_, err := NewGoTTS(
+		context.TODO(),
+		WithSpeechKey("key"),
+		WithEndpoint("http://127.0.0.1:8080"),
+	)
+	if err == nil {
+		t.Fatal("缺少区域且未自定义全部服务地址时应报错")
+	}
+
+	_, err = NewGoTTS(
+		context.TODO(),
+		WithSpeechKey("key"),
+		WithEndpoint("http://127.0.0.1:8080"),
+		WithTokenEndpoint("http://127.0.0.1:8080"),
+		WithBatchEndpoint("http://127.0.0.1:8080"),
+	)
+	if err != nil {
+		t.Fatalf("自定义全部服务地址后不需要区域 err:%v", err)
+	}
+}
@@ -0,0 +1,179 @@
+package go_micro_tts
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUnauthorized = errors.New("unauthorized")           // speechKey 无效或访问令牌已过期（401/403）
+	ErrThrottled    = errors.New("too many requests")      // 请求被限流（429）
+	ErrInvalidSSML  = errors.New("invalid ssml")           // SSML 格式错误或参数无效
+	ErrNotFound     = errors.New("not found")              // 资源不存在（404），如批处理任务 ID 错误
+	ErrServer       = errors.New("service unavailable")    // 服务端错误（5xx）
+	ErrBatchFailed  = errors.New("batch synthesis failed") // 批处理合成任务失败，参见 BatchJobError
+)
+
+// 出错的接口名称，对应 APIError.Op
+const (
+	opIssueToken            = "IssueToken"
+	opGetVoiceList          = "GetVoiceList"
+	opTextToVoice           = "TextToVoice"
+	opLongTextToVoiceCreate = "LongTextToVoiceCreate"
+	opLongTextToVoiceId     = "LongTextToVoiceId"
+	opLongTextToVoice       = "LongTextToVoice"
+	opLongTextToVoiceDel    = "LongTextToVoiceDel"
+	opDownloadResults       = "DownloadResults"
+	opSynthesizeEvents      = "SynthesizeEvents"
+)
+
+// maxErrorBody 读取错误响应体的最大字节数
+const maxErrorBody = 64 << 10
+
+// APIError 接口返回非成功状态码时的错误
+// 可通过 errors.As 获取详细信息，或通过 errors.Is 与 ErrUnauthorized、ErrThrottled 等比较
+type APIError struct {
+	Op         string         // 出错的接口，如 TextToVoice
+	StatusCode int            // HTTP 状态码
+	Status     string         // HTTP 状态，如 "429 Too Many Requests"
+	Code       string         // 服务返回的错误码
+	Message    string         // 服务返回的错误信息
+	InnerError *APIInnerError // 服务返回的内部错误
+	RequestId  string         // 请求 ID，用于向微软排查问题
+	RetryAfter time.Duration  // Retry-After 响应头，未返回时为 0
+	Body       []byte         // 原始响应体
+}
+
+// APIInnerError 服务返回的内部错误
+type APIInnerError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	msg := e.Op + ": " + e.Status
+	if e.Code != "" {
+		msg += ": " + e.Code
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.InnerError != nil && e.InnerError.Message != "" {
+		msg += " (" + e.InnerError.Message + ")"
+	}
+	return msg
+}
+
+// Unwrap 按状态码及错误码返回对应的哨兵错误
+func (e *APIError) Unwrap() error {
+	switch {
+	case e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden:
+		return ErrUnauthorized
+	case e.StatusCode == http.StatusTooManyRequests:
+		return ErrThrottled
+	case e.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case e.StatusCode == http.StatusBadRequest && (e.Op == opTextToVoice || strings.Contains(strings.ToLower(e.Code), "ssml")):
+		return ErrInvalidSSML
+	case e.StatusCode >= http.StatusInternalServerError:
+		return ErrServer
+	}
+	return nil
+}
+
+// apiErrorBody 错误响应体
+// 批处理接口的错误信息位于顶层，其他接口位于 error 字段中
+type apiErrorBody struct {
+	errorDetail
+	Error *errorDetail `json:"error"`
+}
+
+type errorDetail struct {
+	Code       errorCode      `json:"code"`
+	Message    string         `json:"message"`
+	InnerError *APIInnerError `json:"innerError"`
+}
+
+// errorCode 错误码可能为字符串或数字，为 null 时为空
+type errorCode string
+
+func (c *errorCode) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*c = ""
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*c = errorCode(s)
+		return nil
+	}
+	*c = errorCode(strings.Trim(string(data), `"`))
+	return nil
+}
+
+// newAPIError 读取响应并生成 APIError，调用方负责关闭响应体
+func newAPIError(op string, resp *http.Response) *APIError {
+	e := &APIError{
+		Op:         op,
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		RequestId:  requestId(resp.Header),
+		RetryAfter: retryAfter(resp.Header),
+	}
+	if e.Status == "" {
+		e.Status = fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+
+	e.Body, _ = io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	if len(e.Body) == 0 {
+		return e
+	}
+
+	body := &apiErrorBody{}
+	if err := json.Unmarshal(e.Body, body); err != nil {
+		e.Message = strings.TrimSpace(string(e.Body))
+		return e
+	}
+
+	detail := body.errorDetail
+	if body.Error != nil {
+		detail = *body.Error
+	}
+	e.Code = string(detail.Code)
+	e.Message = detail.Message
+	e.InnerError = detail.InnerError
+
+	return e
+}
+
+func requestId(header http.Header) string {
+	for _, key := range []string{"X-RequestId", "X-Request-Id", "Apim-Request-Id"} {
+		if v := header.Get(key); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// retryAfter 解析 Retry-After 响应头，支持秒数及 HTTP 日期两种格式
+func retryAfter(header http.Header) time.Duration {
+	v := header.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(v); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
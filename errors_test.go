@@ -0,0 +1,92 @@
+package go_micro_tts
+
+import (
+	"errors"
+	"github.com/xuemingjings/go-micro-tts/ttstest"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAPIErrorThrottled(t *testing.T) {
+	tts, srv := newTestTTS(t)
+	srv.Throttle(ttstest.PathVoiceList, 1, 3*time.Second)
+
+	_, err := tts.GetVoiceList()
+	if !errors.Is(err, ErrThrottled) {
+		t.Fatalf("err = %v, 期望 ErrThrottled", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("err = %T, 期望 *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusTooManyRequests || apiErr.Code != "TooManyRequests" || apiErr.Op != opGetVoiceList {
+		t.Errorf("apiErr = %+v", apiErr)
+	}
+	if apiErr.RetryAfter != 3*time.Second || apiErr.RequestId == "" {
+		t.Errorf("RetryAfter = %v, RequestId = %q", apiErr.RetryAfter, apiErr.RequestId)
+	}
+}
+
+func TestAPIErrorInvalidSSML(t *testing.T) {
+	tts, srv := newTestTTS(t)
+	srv.Fail(ttstest.PathSynthesis, 1, http.StatusBadRequest, "BadRequest", "The SSML is invalid.")
+
+	ssml := NewSpeakXml(&SpeakXmlReq{Lang: "zh-CN", Name: "zh-CN-YunxiNeural", Text: "你好"})
+	_, funcClose, err := tts.TextToVoice(Audio16kHz32KbitrateMonoMp3, ssml)
+	funcClose()
+	if !errors.Is(err, ErrInvalidSSML) {
+		t.Fatalf("err = %v, 期望 ErrInvalidSSML", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Message != "The SSML is invalid." {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestAPIErrorUnauthorized(t *testing.T) {
+	tts, _ := newTestTTS(t)
+	tts.speechKey = "wrong-key"
+
+	_, err := tts.GetVoiceList()
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("err = %v, 期望 ErrUnauthorized", err)
+	}
+}
+
+func TestAPIErrorBatch(t *testing.T) {
+	tts, _ := newTestTTS(t)
+
+	_, err := tts.LongTextToVoiceCreate(NewLongSpeak(&LongSpeakXmlReq{DisplayName: "空任务"}))
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "InvalidPayload" {
+		t.Fatalf("err = %v", err)
+	}
+
+	_, err = tts.LongTextToVoiceDel("not-exists")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("err = %v, 期望 ErrNotFound", err)
+	}
+}
+
+func TestAPIErrorCode(t *testing.T) {
+	tests := []struct {
+		body string
+		want string
+	}{
+		{`{"error":{"code":"InvalidRequest","message":"m"}}`, "InvalidRequest"},
+		{`{"error":{"code":404,"message":"m"}}`, "404"},
+		{`{"error":{"code":null,"message":"m"}}`, ""},
+		{`{"code":null,"message":"m"}`, ""},
+	}
+	for _, tt := range tests {
+		resp := &http.Response{StatusCode: http.StatusBadRequest, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(tt.body))}
+		if e := newAPIError(opGetVoiceList, resp); e.Code != tt.want || e.Message != "m" {
+			t.Errorf("%s: Code = %q, Message = %q", tt.body, e.Code, e.Message)
+		}
+	}
+}
@@ -0,0 +1,386 @@
+package go_micro_tts
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"github.com/xuemingjings/go-micro-tts/audio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// MaxTextToVoiceDuration TextToVoice 单次请求最多合成的音频时长，更长的内容使用 SynthesizeLong 或批处理合成
+const MaxTextToVoiceDuration = 10 * time.Minute
+
+// ErrAudioTooLong 估算的音频时长超出 WithDurationLimit 设置的上限
+var ErrAudioTooLong = errors.New("estimated audio duration exceeds the limit")
+
+// 未知语音时的默认语速
+const (
+	defaultWordsPerMinute = 150 // 以空格分词的语言，每分钟单词数
+	defaultCJKPerMinute   = 260 // 中日韩文字，每分钟字数
+)
+
+// cjkWordWeight 中日韩语音朗读一个英文等以空格分词的单词，约相当于朗读 1.5 个字
+const cjkWordWeight = 1.5
+
+// ssmlBreakStrengths <break strength> 的停顿时长
+var ssmlBreakStrengths = map[string]time.Duration{
+	"x-weak":   250 * time.Millisecond,
+	"weak":     500 * time.Millisecond,
+	"medium":   750 * time.Millisecond,
+	"strong":   1000 * time.Millisecond,
+	"x-strong": 1250 * time.Millisecond,
+}
+
+// ssmlRateValues <prosody rate> 的语速常量对应的倍率
+var ssmlRateValues = map[string]float64{
+	"x-slow":  0.5,
+	"slow":    0.64,
+	"medium":  1,
+	"default": 1,
+	"fast":    1.55,
+	"x-fast":  2,
+}
+
+// EstimateDuration 估算朗读 text 的音频时长
+// text 可为纯文本或 SSML（以 < 开头）；SSML 中的 <prosody rate> 调整语速，<break>、<mstts:silence> 增加停顿
+// voice 提供语速（WordsPerMinute）及区域设置，为 nil 时按 SSML 中 <voice name> 在 SnapshotVoiceCatalog 中查找，找不到时使用默认语速
+// 中日韩语音的 WordsPerMinute 为每分钟字数，按字计算；其他语音按空格分词计算
+// rate 为语速倍率，如 1.2 表示加快 20%，小于等于 0 时视为 1
+// SSML 格式错误时去掉标签按纯文本估算，需要得知格式错误时使用 ChooseSynthesis
+func EstimateDuration(text string, voice *VoiceList, rate float64) time.Duration {
+	d, err := estimateDuration(text, voice, rate)
+	if err != nil {
+		if rate <= 0 {
+			rate = 1
+		}
+		return time.Duration(float64(speakingTime(plainText(text), voice)) / rate)
+	}
+	return d
+}
+
+// estimateDuration 同 EstimateDuration，SSML 格式错误时返回错误
+func estimateDuration(text string, voice *VoiceList, rate float64) (time.Duration, error) {
+	if rate <= 0 {
+		rate = 1
+	}
+
+	trimmed := strings.TrimSpace(text)
+	if !strings.HasPrefix(trimmed, "<") {
+		return time.Duration(float64(speakingTime(trimmed, voice)) / rate), nil
+	}
+
+	e := &durationEstimator{voice: voice, rates: []float64{rate}}
+	if err := e.ssml([]byte(trimmed)); err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidSSML, err)
+	}
+	return e.total, nil
+}
+
+// EstimateSize 估算 format 格式下 d 时长音频的字节数
+// 格式名称未注明码率时（如 ogg-24khz-16bit-mono-opus）按该编码的常见码率估算
+func EstimateSize(d time.Duration, format SsmlOut) int64 {
+	info := format.Info()
+	bitrate := info.Bitrate
+	if bitrate == 0 {
+		switch info.Codec {
+		case audio.CodecOpus:
+			bitrate = 32000
+		case audio.CodecAMRWB:
+			bitrate = 23850
+		case audio.CodecSILK:
+			bitrate = 24000
+		}
+	}
+
+	size := int64(d.Seconds() * float64(bitrate) / 8)
+	if info.Container == audio.ContainerRIFF {
+		size += 44
+	}
+	return size
+}
+
+// MaxSynthesizeLongDuration ChooseSynthesis 选择 SynthesizeLong 的最大估算时长，
+// 更长的内容分段过多、合成耗时长，建议使用批处理合成
+const MaxSynthesizeLongDuration = time.Hour
+
+// SynthesisRoute 合成方式
+type SynthesisRoute int
+
+const (
+	RouteTextToVoice    SynthesisRoute = iota + 1 // TextToVoice 单次请求
+	RouteSynthesizeLong                           // SynthesizeLong 拆分后并发合成
+	RouteBatch                                    // CreateBatchJob 批处理合成
+)
+
+// String 实现 fmt.Stringer
+func (r SynthesisRoute) String() string {
+	switch r {
+	case RouteTextToVoice:
+		return "TextToVoice"
+	case RouteSynthesizeLong:
+		return "SynthesizeLong"
+	case RouteBatch:
+		return "Batch"
+	}
+	return "SynthesisRoute(" + strconv.Itoa(int(r)) + ")"
+}
+
+// ChooseSynthesis 按估算时长选择合成方式，同时返回估算的时长：
+// 不超过 MaxTextToVoiceDuration 时为 RouteTextToVoice，不超过 MaxSynthesizeLongDuration 时为 RouteSynthesizeLong，否则为 RouteBatch
+// text、voice 同 EstimateDuration，SSML 格式错误时返回可通过 errors.Is(err, ErrInvalidSSML) 判断的错误
+//
+//	route, d, err := ChooseSynthesis(ssml, nil)
+//	switch route {
+//	case RouteTextToVoice:
+//		_, err = tts.TextToVoiceFile(ctx, format, doc, path)
+//	case RouteSynthesizeLong:
+//		_, err = tts.SynthesizeLongFile(ctx, &LongSynthesizeReq{OutputFormat: format, Ssml: doc}, path)
+//	case RouteBatch:
+//		job, err = tts.CreateBatchJob(ctx, longSpeak)
+//	}
+func ChooseSynthesis(text string, voice *VoiceList) (SynthesisRoute, time.Duration, error) {
+	d, err := estimateDuration(text, voice, 1)
+	if err != nil {
+		return 0, 0, err
+	}
+	switch {
+	case d <= MaxTextToVoiceDuration:
+		return RouteTextToVoice, d, nil
+	case d <= MaxSynthesizeLongDuration:
+		return RouteSynthesizeLong, d, nil
+	}
+	return RouteBatch, d, nil
+}
+
+// WithDurationLimit TextToVoice 发送请求前先估算音频时长，超出 limit 时返回 ErrAudioTooLong，
+// limit 为 0 时使用 MaxTextToVoiceDuration；估算存在误差，可设置略小于限制的值
+func WithDurationLimit(limit time.Duration) Option {
+	return func(g *GoTTS) {
+		if limit <= 0 {
+			limit = MaxTextToVoiceDuration
+		}
+		g.durationLimit = limit
+	}
+}
+
+// checkDuration 按 WithDurationLimit 检查 SSML 的估算时长，SSML 格式错误时不发送请求
+func (g *GoTTS) checkDuration(ssml []byte) error {
+	if g.durationLimit <= 0 {
+		return nil
+	}
+	d, err := estimateDuration(string(ssml), nil, 1)
+	if err != nil {
+		return err
+	}
+	if d > g.durationLimit {
+		return fmt.Errorf("%w: estimated %s, limit %s", ErrAudioTooLong, d.Round(time.Second), g.durationLimit)
+	}
+	return nil
+}
+
+// durationEstimator 遍历 SSML 累加朗读及停顿的时长
+type durationEstimator struct {
+	voice  *VoiceList   // 调用方指定的语音
+	voices []*VoiceList // <voice> 对应的语音
+	rates  []float64    // <prosody rate> 的语速倍率
+	total  time.Duration
+}
+
+func (e *durationEstimator) ssml(data []byte) error {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	var stack []string
+	for {
+		tok, err := dec.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			name := xmlName(t.Name)
+			stack = append(stack, name)
+			e.start(name, t.Attr)
+		case xml.EndElement:
+			if len(stack) == 0 || stack[len(stack)-1] != xmlName(t.Name) {
+				return errors.New("ssml: unexpected end element </" + xmlName(t.Name) + ">")
+			}
+			e.end(stack[len(stack)-1])
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			d := speakingTime(string(t), e.currentVoice())
+			e.total += time.Duration(float64(d) / e.rates[len(e.rates)-1])
+		}
+	}
+	if len(stack) > 0 {
+		return errors.New("ssml: unexpected EOF")
+	}
+	return nil
+}
+
+func (e *durationEstimator) start(name string, attrs []xml.Attr) {
+	attr := func(key string) string {
+		for _, a := range attrs {
+			if xmlName(a.Name) == key {
+				return a.Value
+			}
+		}
+		return ""
+	}
+
+	switch name {
+	case "voice":
+		voice := e.voice
+		if voice == nil {
+			voice, _ = SnapshotVoiceCatalog().FindVoice(attr("name"))
+		}
+		e.voices = append(e.voices, voice)
+	case "prosody":
+		e.rates = append(e.rates, e.rates[len(e.rates)-1]*prosodyRate(attr("rate")))
+	case "break":
+		if d, ok := parseSsmlDuration(attr("time")); ok {
+			e.total += d
+		} else if d, ok := ssmlBreakStrengths[attr("strength")]; ok {
+			e.total += d
+		} else {
+			e.total += ssmlBreakStrengths["medium"]
+		}
+	case "mstts:silence":
+		// Sentenceboundary 等逐句插入的静音难以估算，仅计算开头及结尾的静音
+		if typ := attr("type"); strings.HasPrefix(typ, "Leading") || strings.HasPrefix(typ, "Tailing") {
+			if d, ok := parseSsmlDuration(attr("value")); ok {
+				e.total += d
+			}
+		}
+	}
+}
+
+func (e *durationEstimator) end(name string) {
+	switch name {
+	case "voice":
+		e.voices = e.voices[:len(e.voices)-1]
+	case "prosody":
+		e.rates = e.rates[:len(e.rates)-1]
+	}
+}
+
+func (e *durationEstimator) currentVoice() *VoiceList {
+	if len(e.voices) > 0 {
+		return e.voices[len(e.voices)-1]
+	}
+	return e.voice
+}
+
+// speakingTime 按语音的语速估算朗读文本的时长
+func speakingTime(text string, voice *VoiceList) time.Duration {
+	var cjk, words int
+	inWord := false
+	for _, r := range text {
+		switch {
+		case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul):
+			cjk++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if !inWord {
+				words++
+			}
+			inWord = true
+		case r == '\'' || r == '-':
+			// 单词中的撇号、连字符
+		default:
+			inWord = false
+		}
+	}
+
+	wpm := 0
+	locale := ""
+	if voice != nil {
+		wpm = voice.WPM()
+		locale = voice.Locale
+	}
+
+	var minutes float64
+	if isCJKLocale(locale) || (locale == "" && cjk > words) {
+		if wpm <= 0 {
+			wpm = defaultCJKPerMinute
+		}
+		minutes = (float64(cjk) + float64(words)*cjkWordWeight) / float64(wpm)
+	} else {
+		if wpm <= 0 {
+			wpm = defaultWordsPerMinute
+		}
+		minutes = float64(words)/float64(wpm) + float64(cjk)/defaultCJKPerMinute
+	}
+	return time.Duration(minutes * float64(time.Minute))
+}
+
+// isCJKLocale 是否为按字计算语速的区域设置，如 zh-CN、ja-JP、ko-KR、yue-CN
+func isCJKLocale(locale string) bool {
+	lang, _, _ := strings.Cut(strings.ToLower(locale), "-")
+	switch lang {
+	case "zh", "ja", "ko", "yue", "wuu":
+		return true
+	}
+	return false
+}
+
+// prosodyRate 解析 <prosody rate> 的语速倍率：+20%、-10%、1.5、fast 等，无法解析时为 1
+func prosodyRate(rate string) float64 {
+	rate = strings.TrimSpace(rate)
+	if v, ok := ssmlRateValues[rate]; ok {
+		return v
+	}
+	if p, ok := strings.CutSuffix(rate, "%"); ok {
+		if f, err := strconv.ParseFloat(p, 64); err == nil && f > -100 {
+			return 1 + f/100
+		}
+		return 1
+	}
+	if f, err := strconv.ParseFloat(rate, 64); err == nil && f > 0 {
+		return f
+	}
+	return 1
+}
+
+// parseSsmlDuration 解析 SSML 的时间，如 500ms、2s
+func parseSsmlDuration(s string) (time.Duration, bool) {
+	s = strings.TrimSpace(s)
+	unit := time.Second
+	if v, ok := strings.CutSuffix(s, "ms"); ok {
+		s, unit = v, time.Millisecond
+	} else if v, ok := strings.CutSuffix(s, "s"); ok {
+		s = v
+	} else {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f < 0 {
+		return 0, false
+	}
+	return time.Duration(f * float64(unit)), true
+}
+
+// plainText 去掉标签后的文本
+func plainText(s string) string {
+	var sb strings.Builder
+	inTag := false
+	for _, r := range s {
+		switch {
+		case r == '<':
+			inTag = true
+		case r == '>':
+			inTag = false
+		case !inTag:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
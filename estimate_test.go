@@ -0,0 +1,144 @@
+package go_micro_tts
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEstimateDuration(t *testing.T) {
+	en := &VoiceList{Locale: "en-US", WordsPerMinute: "150"}
+	zh := &VoiceList{Locale: "zh-CN", WordsPerMinute: "240"}
+
+	text := strings.Repeat("hello world ", 75) // 150 个单词
+	if d := EstimateDuration(text, en, 1); d != time.Minute {
+		t.Errorf("en = %s", d)
+	}
+	if d := EstimateDuration(text, en, 2); d != 30*time.Second {
+		t.Errorf("rate 2 = %s", d)
+	}
+	if d := EstimateDuration(strings.Repeat("你好", 120), zh, 0); d != time.Minute {
+		t.Errorf("zh = %s", d)
+	}
+	// 中文语音朗读英文单词按 1.5 个字计算
+	if d := EstimateDuration(strings.Repeat("AI ", 160), zh, 1); d != time.Minute {
+		t.Errorf("zh words = %s", d)
+	}
+	// 未知语音时按文字判断，使用默认语速
+	if d := EstimateDuration(strings.Repeat("字", defaultCJKPerMinute), nil, 1); d != time.Minute {
+		t.Errorf("default cjk = %s", d)
+	}
+	if d := EstimateDuration("", en, 1); d != 0 {
+		t.Errorf("empty = %s", d)
+	}
+}
+
+func TestEstimateDurationSSML(t *testing.T) {
+	en := &VoiceList{Locale: "en-US", WordsPerMinute: "150"}
+	words := strings.Repeat("hello world ", 75)
+
+	tests := []struct {
+		ssml string
+		want time.Duration
+	}{
+		{`<speak>` + words + `</speak>`, time.Minute},
+		{`<speak><prosody rate="+100%">` + words + `</prosody></speak>`, 30 * time.Second},
+		{`<speak><prosody rate="x-slow">` + words + `</prosody></speak>`, 2 * time.Minute},
+		{`<speak><prosody rate="2"><prosody rate="-50%">` + words + `</prosody></prosody></speak>`, time.Minute},
+		{`<speak>` + words + `<break time="1500ms"/><break time="2s"/></speak>`, time.Minute + 3500*time.Millisecond},
+		{`<speak><break strength="strong"/><break/></speak>`, 1750 * time.Millisecond},
+		{`<speak><mstts:silence type="Leading" value="200ms"/><mstts:silence type="Sentenceboundary" value="1s"/></speak>`, 200 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		if d := EstimateDuration(tt.ssml, en, 1); d != tt.want {
+			t.Errorf("%.60s... = %s, want %s", tt.ssml, d, tt.want)
+		}
+	}
+
+	// 未指定语音时按 <voice name> 查找语速
+	ssml := NewSsmlSpeak("zh-CN").Voice("zh-CN-XiaoxiaoNeural", SsmlText(strings.Repeat("你好", 500)))
+	data, err := ssml.Ssml()
+	if err != nil {
+		t.Fatal(err)
+	}
+	voice, ok := SnapshotVoiceCatalog().FindVoice("zh-CN-XiaoxiaoNeural")
+	if !ok || voice.WPM() == 0 {
+		t.Fatal("快照中缺少 zh-CN-XiaoxiaoNeural 的语速")
+	}
+	want := time.Duration(1000 * float64(time.Minute) / float64(voice.WPM()))
+	if d := EstimateDuration(string(data), nil, 1); d < want-time.Second || d > want+time.Second {
+		t.Errorf("voice = %s, want %s", d, want)
+	}
+}
+
+func TestEstimateSize(t *testing.T) {
+	if n := EstimateSize(10*time.Second, Audio24kHz48KbitrateMonoMp3); n != 60000 {
+		t.Errorf("mp3 = %d", n)
+	}
+	if n := EstimateSize(time.Second, Riff16kHz16BitMonoPcm); n != 32044 {
+		t.Errorf("riff = %d", n)
+	}
+	if n := EstimateSize(time.Second, Ogg24kHz16BitMonoOpus); n != 4000 {
+		t.Errorf("opus = %d", n)
+	}
+	if n := EstimateSize(time.Second, SsmlOut("unknown")); n != 0 {
+		t.Errorf("unknown = %d", n)
+	}
+}
+
+func TestChooseSynthesis(t *testing.T) {
+	en := &VoiceList{Locale: "en-US", WordsPerMinute: "150"}
+	tests := []struct {
+		minutes int
+		want    SynthesisRoute
+	}{
+		{10, RouteTextToVoice},
+		{11, RouteSynthesizeLong},
+		{60, RouteSynthesizeLong},
+		{61, RouteBatch},
+	}
+	for _, tt := range tests {
+		text := strings.Repeat("word ", tt.minutes*150)
+		route, d, err := ChooseSynthesis(text, en)
+		if err != nil || route != tt.want || d != time.Duration(tt.minutes)*time.Minute {
+			t.Errorf("%d 分钟 = %s, %s, %v", tt.minutes, route, d, err)
+		}
+	}
+
+	if _, _, err := ChooseSynthesis("<speak><voice>你好</speak>", nil); !errors.Is(err, ErrInvalidSSML) {
+		t.Errorf("格式错误的 SSML err = %v", err)
+	}
+}
+
+func TestTextToVoiceDurationLimit(t *testing.T) {
+	tts, srv := newTestTTS(t)
+	WithDurationLimit(time.Minute)(tts)
+
+	doc := NewSsmlSpeak("zh-CN").Voice("zh-CN-XiaoxiaoNeural", SsmlText(strings.Repeat("你好", 500)))
+	_, funcClose, err := tts.TextToVoice(Audio16kHz32KbitrateMonoMp3, doc)
+	funcClose()
+	if !errors.Is(err, ErrAudioTooLong) {
+		t.Fatalf("err = %v, 期望 ErrAudioTooLong", err)
+	}
+	if n := srv.Requests(""); n != 0 {
+		t.Errorf("超出时长限制时不应发送请求, 请求次数 = %d", n)
+	}
+
+	// 格式错误的 SSML 不发送请求
+	_, funcClose, err = tts.TextToVoice(Audio16kHz32KbitrateMonoMp3, ssmlBytes("<speak><voice>你好</speak>"))
+	funcClose()
+	if !errors.Is(err, ErrInvalidSSML) {
+		t.Fatalf("err = %v, 期望 ErrInvalidSSML", err)
+	}
+	if n := srv.Requests(""); n != 0 {
+		t.Errorf("SSML 格式错误时不应发送请求, 请求次数 = %d", n)
+	}
+
+	doc = NewSsmlSpeak("zh-CN").Voice("zh-CN-XiaoxiaoNeural", SsmlText("你好"))
+	_, funcClose, err = tts.TextToVoice(Audio16kHz32KbitrateMonoMp3, doc)
+	funcClose()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
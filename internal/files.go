@@ -3,25 +3,48 @@ package internal
 import (
 	"io"
 	"os"
+	"path/filepath"
 )
 
-func WriteToDisk(respBody io.Reader, outFile *os.File) error {
-	const bufferSize = 4096
-	buffer := make([]byte, bufferSize)
-	for {
-		bytesRead, err := respBody.Read(buffer)
-		if err == io.EOF {
-			break
-		}
-		if err != nil && err != io.EOF {
-			return err
-		}
-		if bytesRead == 0 {
-			break
-		}
-		if _, err := outFile.Write(buffer[:bytesRead]); err != nil {
-			return err
+// WriteFileAtomic 先写入同目录下的临时文件，成功后再重命名为 path，写入失败时不会在 path 留下不完整的文件
+func WriteFileAtomic(path string, perm os.FileMode, write func(w io.Writer) (int64, error)) (int64, error) {
+	dir, name := filepath.Split(path)
+	if dir == "" {
+		dir = "."
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+name+".*.tmp")
+	if err != nil {
+		return 0, err
+	}
+	tmpName := tmp.Name()
+
+	// 失败时清理临时文件
+	success := false
+	defer func() {
+		if !success {
+			tmp.Close()
+			os.Remove(tmpName)
 		}
+	}()
+
+	n, err := write(tmp)
+	if err != nil {
+		return n, err
+	}
+	if err := tmp.Sync(); err != nil {
+		return n, err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		return n, err
 	}
-	return nil
+	if err := tmp.Close(); err != nil {
+		return n, err
+	}
+	if err := os.Rename(tmpName, path); err != nil {
+		return n, err
+	}
+
+	success = true
+	return n, nil
 }
@@ -0,0 +1,198 @@
+// voices 由语音列表接口返回的 JSON 生成内置的语音快照
+//
+//	curl -H "Ocp-Apim-Subscription-Key: $SPEECH_KEY" \
+//		https://eastus.tts.speech.microsoft.com/cognitiveservices/voices/list > voices.json
+//	go run ./internal/gen/voices -in voices.json -out voice_snapshot.json -go voices_gen.go -md voiceList.md
+//
+// -in 可以是接口返回的 JSON，也可以是包含 ```json 代码块的 Markdown 文件（如 voiceList.md）
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"unicode"
+)
+
+const mdHeader = "# 获取语音列表\n\n" +
+	"以下包含所有受支持的区域设置、声音、性别、风格和其他详细信息的 `JSON` 正文的响应。 \n" +
+	"每个语音的 `WordsPerMinute` 属性可用于估计输出语音的长度。\n\n"
+
+func main() {
+	in := flag.String("in", "voiceList.md", "语音列表 JSON 或包含 ```json 代码块的 Markdown 文件")
+	out := flag.String("out", "voice_snapshot.json", "生成的语音快照")
+	goOut := flag.String("go", "", "生成的 Voice、Locale 常量文件，为空时不生成")
+	md := flag.String("md", "", "同时重新生成的 Markdown 文档，为空时不生成")
+	flag.Parse()
+
+	data, err := os.ReadFile(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	voices, err := parseVoices(data)
+	if err != nil {
+		log.Fatalf("%s: %v", *in, err)
+	}
+
+	if err := os.WriteFile(*out, snapshot(voices), 0o644); err != nil {
+		log.Fatal(err)
+	}
+	if *goOut != "" {
+		src, err := constants(voices)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(*goOut, src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if *md != "" {
+		if err := os.WriteFile(*md, markdown(voices), 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+	log.Printf("generated %d voices", len(voices))
+}
+
+// voice 保留接口返回的全部字段，只解析校验需要的字段
+type voice struct {
+	ShortName  string
+	LocalName  string
+	Gender     string
+	Locale     string
+	LocaleName string
+	raw        json.RawMessage
+}
+
+// parseVoices 解析语音列表，保持接口返回的顺序，短名称重复时报错
+func parseVoices(data []byte) ([]voice, error) {
+	if start := bytes.Index(data, []byte("```json")); start >= 0 {
+		data = data[start+len("```json"):]
+		end := bytes.Index(data, []byte("```"))
+		if end < 0 {
+			return nil, errors.New("unterminated ```json block")
+		}
+		data = data[:end]
+	}
+
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return nil, err
+	}
+
+	voices := make([]voice, 0, len(raws))
+	seen := map[string]bool{}
+	for i, raw := range raws {
+		v := voice{}
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("voice %d: %w", i, err)
+		}
+		if v.ShortName == "" || v.Locale == "" {
+			return nil, fmt.Errorf("voice %d: ShortName and Locale are required", i)
+		}
+		if seen[v.ShortName] {
+			return nil, fmt.Errorf("voice %d: duplicate ShortName %q", i, v.ShortName)
+		}
+		seen[v.ShortName] = true
+		v.raw = raw
+		voices = append(voices, v)
+	}
+	return voices, nil
+}
+
+// snapshot 每行一个语音，便于比较不同版本的差异
+func snapshot(voices []voice) []byte {
+	buf := &bytes.Buffer{}
+	buf.WriteString("[\n")
+	for i, v := range voices {
+		_ = json.Compact(buf, v.raw)
+		if i < len(voices)-1 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("\n")
+	}
+	buf.WriteString("]\n")
+	return buf.Bytes()
+}
+
+func markdown(voices []voice) []byte {
+	buf := &bytes.Buffer{}
+	buf.WriteString(mdHeader)
+	buf.WriteString("```json\n[\n")
+	for i, v := range voices {
+		buf.WriteString("    ")
+		_ = json.Indent(buf, v.raw, "    ", "    ")
+		if i < len(voices)-1 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("\n")
+	}
+	buf.WriteString("]\n```\n")
+	return buf.Bytes()
+}
+
+// constants 生成 Voice、Locale 常量及语音的区域设置、性别对照表
+func constants(voices []voice) ([]byte, error) {
+	var locales []voice
+	voiceIdents := map[string]string{}
+	localeIdents := map[string]string{}
+	for _, v := range voices {
+		if prev, ok := voiceIdents[ident(v.ShortName)]; ok {
+			return nil, fmt.Errorf("voices %s and %s have the same identifier", prev, v.ShortName)
+		}
+		voiceIdents[ident(v.ShortName)] = v.ShortName
+
+		prev, ok := localeIdents[ident(v.Locale)]
+		if ok && prev != v.Locale {
+			return nil, fmt.Errorf("locales %s and %s have the same identifier", prev, v.Locale)
+		}
+		if !ok {
+			localeIdents[ident(v.Locale)] = v.Locale
+			locales = append(locales, v)
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	buf.WriteString("// Code generated by internal/gen/voices; DO NOT EDIT.\n\n")
+	buf.WriteString("package go_micro_tts\n\n")
+
+	buf.WriteString("// 语音列表中的全部语音\nconst (\n")
+	for _, v := range voices {
+		fmt.Fprintf(buf, "\tVoice%s Voice = %q // %s，%s\n", ident(v.ShortName), v.ShortName, v.LocalName, v.Gender)
+	}
+	buf.WriteString(")\n\n")
+
+	buf.WriteString("// 语音列表中的全部区域设置\nconst (\n")
+	for _, v := range locales {
+		fmt.Fprintf(buf, "\tLocale%s Locale = %q // %s\n", ident(v.Locale), v.Locale, v.LocaleName)
+	}
+	buf.WriteString(")\n\n")
+
+	buf.WriteString("// voiceMetas 语音的区域设置及性别\nvar voiceMetas = map[Voice]voiceMeta{\n")
+	for _, v := range voices {
+		fmt.Fprintf(buf, "\tVoice%s: {Locale%s, %q},\n", ident(v.ShortName), ident(v.Locale), v.Gender)
+	}
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
+
+// ident 将 zh-CN-YunxiNeural 转为 Go 标识符 ZhCNYunxiNeural
+func ident(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, p := range parts {
+		r := []rune(p)
+		r[0] = unicode.ToUpper(r[0])
+		parts[i] = string(r)
+	}
+	return strings.Join(parts, "")
+}
@@ -26,9 +26,13 @@ var (
 type HTTPClient struct {
 	ctx              context.Context
 	client           *http.Client
+	timeout          time.Duration
+	headerTimeout    time.Duration
 	contentType      HttpType
 	header           map[string]any
 	requestLogSwitch bool
+	retry            RetryFunc
+	idempotent       *bool
 	body             any
 	httpReq          *http.Request
 	httpRep          *http.Response
@@ -36,14 +40,19 @@ type HTTPClient struct {
 
 const timeout = 5 * time.Second
 
+// ErrHeaderTimeout 设置了 WithHeaderTimeout 时，未在超时时间内收到响应头
+var ErrHeaderTimeout = errors.New("http: timeout awaiting response headers")
+
 type Option func(*HTTPClient)
 
+// RetryFunc 判断第 attempt 次请求后是否需要重试，返回重试前的等待时间
+// idempotent 表示该请求重复发送是否安全，resp 与 err 为本次请求的结果
+type RetryFunc func(attempt int, idempotent bool, resp *http.Response, err error) (time.Duration, bool)
+
 func NewHTTPClient(ctx context.Context, opts ...Option) *HTTPClient {
 	h := &HTTPClient{
-		ctx: ctx,
-		client: &http.Client{
-			Timeout: timeout,
-		},
+		ctx:     ctx,
+		timeout: timeout,
 		//requestLogSwitch: true,
 	}
 
@@ -51,12 +60,40 @@ func NewHTTPClient(ctx context.Context, opts ...Option) *HTTPClient {
 		o(h)
 	}
 
+	// 复制一份 http.Client 再设置超时，共享的 Transport 仍可复用连接
+	client := http.Client{}
+	if h.client != nil {
+		client = *h.client
+	}
+	if h.headerTimeout > 0 {
+		// http.Client.Timeout 包含读取响应体的时间，流式读取时不能使用
+		client.Timeout = 0
+	} else if h.timeout > 0 {
+		client.Timeout = h.timeout
+	}
+	h.client = &client
+
 	return h
 }
 
+// WithClient 使用外部传入的 http.Client 发送请求
+func WithClient(client *http.Client) Option {
+	return func(h *HTTPClient) {
+		h.client = client
+	}
+}
+
 func WithTimeout(timeout time.Duration) Option {
 	return func(h *HTTPClient) {
-		h.client.Timeout = timeout
+		h.timeout = timeout
+	}
+}
+
+// WithHeaderTimeout 只限制等待响应头的时间，收到响应头后读取响应体的时间不受限制（由 ctx 控制），用于流式读取
+// 设置后忽略 WithTimeout
+func WithHeaderTimeout(timeout time.Duration) Option {
+	return func(h *HTTPClient) {
+		h.headerTimeout = timeout
 	}
 }
 
@@ -72,6 +109,20 @@ func WithHeader(header map[string]any) Option {
 	}
 }
 
+// WithRetry 设置请求失败时的重试策略
+func WithRetry(retry RetryFunc) Option {
+	return func(h *HTTPClient) {
+		h.retry = retry
+	}
+}
+
+// WithIdempotent 标记请求重复发送是否安全，默认 GET、DELETE 为幂等请求
+func WithIdempotent(idempotent bool) Option {
+	return func(h *HTTPClient) {
+		h.idempotent = &idempotent
+	}
+}
+
 func WithRequestLogSwitch(switchLog bool) Option {
 	return func(h *HTTPClient) {
 		h.requestLogSwitch = switchLog
@@ -97,27 +148,56 @@ func (hc *HTTPClient) SendRequest(method, url string, body map[string]any) (*htt
 		return nil, func() {}, errors.New("not define method: " + method)
 	}
 
-	req, err := http.NewRequest(method, url, bytes.NewBuffer(reqBody))
-	hc.httpReq = req
-	if err != nil {
-		return nil, func() {}, err
+	idempotent := method == http.MethodGet || method == http.MethodDelete
+	if hc.idempotent != nil {
+		idempotent = *hc.idempotent
 	}
 
-	hc.setHeader(req)
-	req.Header.Set("Content-Length", fmt.Sprintf("%d", len(reqBody)))
+	for attempt := 1; ; attempt++ {
+		ctx, cancel := context.WithCancel(hc.context())
+		req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(reqBody))
+		hc.httpReq = req
+		if err != nil {
+			cancel()
+			return nil, func() {}, err
+		}
 
-	resp, err := hc.client.Do(req)
-	hc.httpRep = resp
-	if err != nil {
-		return nil, func() {}, err
+		hc.setHeader(req)
+		req.Header.Set("Content-Length", fmt.Sprintf("%d", len(reqBody)))
+
+		resp, err := hc.do(req, cancel)
+		hc.httpRep = resp
+
+		delay, retry := time.Duration(0), false
+		if hc.retry != nil {
+			delay, retry = hc.retry(attempt, idempotent, resp, err)
+		}
+		if !retry {
+			if err != nil {
+				cancel()
+				return nil, func() {}, err
+			}
+			return resp, func() {
+				resp.Body.Close()
+				cancel()
+			}, nil
+		}
+
+		// 丢弃本次响应后等待重试
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+			resp.Body.Close()
+		}
+		cancel()
+		if err := Sleep(hc.context(), delay); err != nil {
+			return nil, func() {}, err
+		}
 	}
 
 	//if resp.StatusCode != http.StatusOK {
 	//	return nil, func() { resp.Body.Close() }, errors.New("http response code failed, code: " + resp.Status)
 	//}
 
-	return resp, func() { resp.Body.Close() }, nil
-
 	//respBody, err := io.ReadAll(resp.Body)
 	//if err != nil {
 	//	return nil, err
@@ -125,6 +205,44 @@ func (hc *HTTPClient) SendRequest(method, url string, body map[string]any) (*htt
 	//return respBody, nil
 }
 
+// do 发送请求，设置了 headerTimeout 时超时未收到响应头则通过 cancel 中断请求
+func (hc *HTTPClient) do(req *http.Request, cancel context.CancelFunc) (*http.Response, error) {
+	if hc.headerTimeout <= 0 {
+		return hc.client.Do(req)
+	}
+
+	timer := time.AfterFunc(hc.headerTimeout, cancel)
+	resp, err := hc.client.Do(req)
+	if !timer.Stop() {
+		// 超时后 cancel 已被调用，即使收到了响应也无法继续读取
+		if resp != nil {
+			resp.Body.Close()
+		}
+		return nil, &url.Error{Op: req.Method, URL: req.URL.String(), Err: ErrHeaderTimeout}
+	}
+	return resp, err
+}
+
+func (hc *HTTPClient) context() context.Context {
+	if hc.ctx == nil {
+		return context.Background()
+	}
+	return hc.ctx
+}
+
+// Sleep 等待 d，ctx 结束时提前返回 ctx.Err()
+func Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 // 设置 Http Header
 func (hc *HTTPClient) setHeader(req *http.Request) {
 	// 自定义 Header
@@ -157,6 +275,10 @@ func (hc *HTTPClient) setPost(body map[string]any) []byte {
 		if !ok {
 			return nil
 		}
+		// 已序列化的 SSML 直接发送
+		if xmlBytes, ok := xmlData.([]byte); ok {
+			return xmlBytes
+		}
 		xmlBytes, _ := xml.MarshalIndent(xmlData, "", "    ")
 		return xmlBytes
 	}
@@ -1,6 +1,15 @@
 package internal
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
 
 func TestHttpPost(t *testing.T) {
 
@@ -9,3 +18,47 @@ func TestHttpPost(t *testing.T) {
 func TestHttpGet(t *testing.T) {
 
 }
+
+func TestHeaderTimeoutStreaming(t *testing.T) {
+	// 响应头立即返回，响应体的读取时间远超 headerTimeout
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		for i := 0; i < 5; i++ {
+			w.Write([]byte("chunk"))
+			w.(http.Flusher).Flush()
+			time.Sleep(30 * time.Millisecond)
+		}
+	}))
+	defer srv.Close()
+
+	client := NewHTTPClient(context.TODO(), WithClient(srv.Client()), WithTimeout(50*time.Millisecond), WithHeaderTimeout(50*time.Millisecond))
+	resp, funcClose, err := client.SendRequest(http.MethodGet, srv.URL, nil)
+	defer funcClose()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("流式读取报错 err:%v", err)
+	}
+	if string(data) != strings.Repeat("chunk", 5) {
+		t.Errorf("data = %s", data)
+	}
+}
+
+func TestHeaderTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer srv.Close()
+
+	client := NewHTTPClient(context.TODO(), WithClient(srv.Client()), WithHeaderTimeout(50*time.Millisecond))
+	_, funcClose, err := client.SendRequest(http.MethodGet, srv.URL, nil)
+	funcClose()
+	if !errors.Is(err, ErrHeaderTimeout) {
+		t.Fatalf("err = %v, 期望 ErrHeaderTimeout", err)
+	}
+}
@@ -0,0 +1,391 @@
+// Package websocket 实现语音服务所需的最小 WebSocket（RFC 6455）客户端与服务端
+// 支持文本、二进制消息及分片、ping/pong 与关闭握手，不支持扩展
+package websocket
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// 消息类型
+const (
+	TextMessage   = 1
+	BinaryMessage = 2
+)
+
+// 控制帧
+const (
+	closeMessage = 8
+	pingMessage  = 9
+	pongMessage  = 10
+)
+
+// 关闭状态码
+const (
+	CloseNormal          = 1000
+	CloseGoingAway       = 1001
+	CloseProtocolError   = 1002
+	CloseNoStatus        = 1005
+	CloseAbnormal        = 1006
+	CloseInvalidPayload  = 1007
+	ClosePolicyViolation = 1008
+	CloseMessageTooBig   = 1009
+	CloseInternalError   = 1011
+)
+
+const (
+	continuationFrame     = 0
+	maxControlPayload     = 125
+	defaultMaxMessageSize = 64 << 20
+)
+
+const acceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// ErrBadHandshake 服务端未返回 101 Switching Protocols
+var ErrBadHandshake = errors.New("websocket: bad handshake")
+
+// CloseError 对端发送的关闭帧
+type CloseError struct {
+	Code   int
+	Reason string
+}
+
+func (e *CloseError) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("websocket: close %d", e.Code)
+	}
+	return fmt.Sprintf("websocket: close %d: %s", e.Code, e.Reason)
+}
+
+// Conn WebSocket 连接，ReadMessage 与 WriteMessage 可分别在不同的 goroutine 中调用
+type Conn struct {
+	rwc    io.ReadWriteCloser
+	br     *bufio.Reader
+	client bool // 客户端发送的帧需要掩码
+
+	MaxMessageSize int64 // 单条消息的最大字节数，默认 64MB
+
+	wmu       sync.Mutex
+	closeOnce sync.Once
+	closeSent bool
+}
+
+func newConn(rwc io.ReadWriteCloser, br *bufio.Reader, client bool) *Conn {
+	if br == nil {
+		br = bufio.NewReader(rwc)
+	}
+	return &Conn{rwc: rwc, br: br, client: client, MaxMessageSize: defaultMaxMessageSize}
+}
+
+// Dial 通过 client 发起 WebSocket 握手，url 的协议可以是 ws、wss、http 或 https
+// client 为 nil 时使用 http.DefaultClient；握手失败时返回服务端的响应，调用方负责关闭响应体
+func Dial(ctx context.Context, client *http.Client, url string, header http.Header) (*Conn, *http.Response, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	// 连接建立后由调用方控制生命周期，不能使用 http.Client 的超时
+	c := *client
+	c.Timeout = 0
+
+	switch {
+	case strings.HasPrefix(url, "ws://"):
+		url = "http://" + strings.TrimPrefix(url, "ws://")
+	case strings.HasPrefix(url, "wss://"):
+		url = "https://" + strings.TrimPrefix(url, "wss://")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	key := newKey()
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", key)
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		return nil, resp, ErrBadHandshake
+	}
+
+	rwc, ok := resp.Body.(io.ReadWriteCloser)
+	if !ok || resp.Header.Get("Sec-WebSocket-Accept") != acceptKey(key) {
+		resp.Body.Close()
+		return nil, resp, ErrBadHandshake
+	}
+	return newConn(rwc, nil, true), resp, nil
+}
+
+// Upgrade 将 HTTP 请求升级为 WebSocket 连接
+func Upgrade(w http.ResponseWriter, r *http.Request) (*Conn, error) {
+	if !headerContains(r.Header, "Connection", "upgrade") || !headerContains(r.Header, "Upgrade", "websocket") {
+		http.Error(w, "websocket: not a websocket handshake", http.StatusBadRequest)
+		return nil, ErrBadHandshake
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if r.Header.Get("Sec-WebSocket-Version") != "13" || key == "" {
+		http.Error(w, "websocket: unsupported version", http.StatusBadRequest)
+		return nil, ErrBadHandshake
+	}
+
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "websocket: hijacking not supported", http.StatusInternalServerError)
+		return nil, errors.New("websocket: response does not implement http.Hijacker")
+	}
+	nc, brw, err := hj.Hijack()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := "HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + acceptKey(key) + "\r\n\r\n"
+	if _, err := nc.Write([]byte(resp)); err != nil {
+		nc.Close()
+		return nil, err
+	}
+	return newConn(nc, brw.Reader, false), nil
+}
+
+// ReadMessage 读取一条完整的消息，自动回复 ping 及关闭帧
+// 对端关闭连接时返回 *CloseError
+func (c *Conn) ReadMessage() (int, []byte, error) {
+	var (
+		messageType int
+		data        []byte
+	)
+	for {
+		fin, opcode, payload, err := c.readFrame()
+		if err != nil {
+			return 0, nil, err
+		}
+
+		switch opcode {
+		case pingMessage:
+			if err := c.writeFrame(pongMessage, payload); err != nil {
+				return 0, nil, err
+			}
+			continue
+		case pongMessage:
+			continue
+		case closeMessage:
+			ce := &CloseError{Code: CloseNoStatus}
+			if len(payload) >= 2 {
+				ce.Code = int(binary.BigEndian.Uint16(payload))
+				ce.Reason = string(payload[2:])
+			}
+			_ = c.writeClose(ce.Code, "")
+			c.rwc.Close()
+			return 0, nil, ce
+		case TextMessage, BinaryMessage:
+			if messageType != 0 {
+				return 0, nil, c.protocolError("unexpected data frame in fragmented message")
+			}
+			messageType = opcode
+		case continuationFrame:
+			if messageType == 0 {
+				return 0, nil, c.protocolError("unexpected continuation frame")
+			}
+		default:
+			return 0, nil, c.protocolError(fmt.Sprintf("unknown opcode %d", opcode))
+		}
+
+		if int64(len(data)+len(payload)) > c.MaxMessageSize {
+			_ = c.writeClose(CloseMessageTooBig, "")
+			c.rwc.Close()
+			return 0, nil, &CloseError{Code: CloseMessageTooBig, Reason: "message too big"}
+		}
+		data = append(data, payload...)
+		if fin {
+			return messageType, data, nil
+		}
+	}
+}
+
+// WriteMessage 发送一条文本或二进制消息
+func (c *Conn) WriteMessage(messageType int, data []byte) error {
+	if messageType != TextMessage && messageType != BinaryMessage {
+		return fmt.Errorf("websocket: invalid message type %d", messageType)
+	}
+	return c.writeFrame(messageType, data)
+}
+
+// CloseWithReason 发送关闭帧后关闭连接
+func (c *Conn) CloseWithReason(code int, reason string) error {
+	err := c.writeClose(code, reason)
+	c.closeOnce.Do(func() {
+		if cerr := c.rwc.Close(); err == nil {
+			err = cerr
+		}
+	})
+	return err
+}
+
+// Close 正常关闭连接
+func (c *Conn) Close() error {
+	return c.CloseWithReason(CloseNormal, "")
+}
+
+func (c *Conn) protocolError(msg string) error {
+	_ = c.writeClose(CloseProtocolError, msg)
+	c.rwc.Close()
+	return errors.New("websocket: " + msg)
+}
+
+func (c *Conn) writeClose(code int, reason string) error {
+	c.wmu.Lock()
+	sent := c.closeSent
+	c.closeSent = true
+	c.wmu.Unlock()
+	if sent {
+		return nil
+	}
+
+	if len(reason) > maxControlPayload-2 {
+		reason = reason[:maxControlPayload-2]
+	}
+	payload := make([]byte, 2+len(reason))
+	binary.BigEndian.PutUint16(payload, uint16(code))
+	copy(payload[2:], reason)
+	return c.writeFrame(closeMessage, payload)
+}
+
+func (c *Conn) readFrame() (fin bool, opcode int, payload []byte, err error) {
+	var head [2]byte
+	if _, err = io.ReadFull(c.br, head[:]); err != nil {
+		return false, 0, nil, err
+	}
+	fin = head[0]&0x80 != 0
+	if head[0]&0x70 != 0 {
+		return false, 0, nil, c.protocolError("reserved bits set")
+	}
+	opcode = int(head[0] & 0x0f)
+	masked := head[1]&0x80 != 0
+	if masked == c.client {
+		// 服务端发送的帧不能有掩码，客户端发送的帧必须有掩码
+		return false, 0, nil, c.protocolError("invalid frame mask")
+	}
+
+	length := int64(head[1] & 0x7f)
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err = io.ReadFull(c.br, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = int64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err = io.ReadFull(c.br, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = int64(binary.BigEndian.Uint64(ext[:]))
+	}
+	if opcode >= closeMessage && (length > maxControlPayload || !fin) {
+		return false, 0, nil, c.protocolError("invalid control frame")
+	}
+	if length < 0 || length > c.MaxMessageSize {
+		_ = c.writeClose(CloseMessageTooBig, "")
+		c.rwc.Close()
+		return false, 0, nil, &CloseError{Code: CloseMessageTooBig, Reason: "message too big"}
+	}
+
+	var mask [4]byte
+	if masked {
+		if _, err = io.ReadFull(c.br, mask[:]); err != nil {
+			return false, 0, nil, err
+		}
+	}
+
+	payload = make([]byte, length)
+	if _, err = io.ReadFull(c.br, payload); err != nil {
+		return false, 0, nil, err
+	}
+	if masked {
+		maskBytes(mask, payload)
+	}
+	return fin, opcode, payload, nil
+}
+
+func (c *Conn) writeFrame(opcode int, payload []byte) error {
+	buf := make([]byte, 0, 14+len(payload))
+	buf = append(buf, 0x80|byte(opcode))
+
+	maskBit := byte(0)
+	if c.client {
+		maskBit = 0x80
+	}
+	switch n := len(payload); {
+	case n <= 125:
+		buf = append(buf, maskBit|byte(n))
+	case n <= 0xffff:
+		buf = append(buf, maskBit|126)
+		buf = binary.BigEndian.AppendUint16(buf, uint16(n))
+	default:
+		buf = append(buf, maskBit|127)
+		buf = binary.BigEndian.AppendUint64(buf, uint64(n))
+	}
+
+	if c.client {
+		var mask [4]byte
+		_, _ = rand.Read(mask[:])
+		buf = append(buf, mask[:]...)
+		start := len(buf)
+		buf = append(buf, payload...)
+		maskBytes(mask, buf[start:])
+	} else {
+		buf = append(buf, payload...)
+	}
+
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	_, err := c.rwc.Write(buf)
+	return err
+}
+
+func maskBytes(mask [4]byte, b []byte) {
+	for i := range b {
+		b[i] ^= mask[i%4]
+	}
+}
+
+func newKey() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return base64.StdEncoding.EncodeToString(b[:])
+}
+
+func acceptKey(key string) string {
+	h := sha1.Sum([]byte(key + acceptGUID))
+	return base64.StdEncoding.EncodeToString(h[:])
+}
+
+func headerContains(header http.Header, name, token string) bool {
+	for _, v := range header.Values(name) {
+		for _, t := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package websocket
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// echoServer 原样返回收到的消息，收到 "close" 时以 1007 关闭连接
+func echoServer(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		conn, err := Upgrade(w, r)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			typ, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if string(data) == "close" {
+				_ = conn.CloseWithReason(CloseInvalidPayload, "bye")
+				return
+			}
+			if err := conn.WriteMessage(typ, data); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestDial(t *testing.T) {
+	srv := echoServer(t)
+	header := http.Header{"Authorization": {"Bearer token"}}
+
+	conn, _, err := Dial(context.TODO(), srv.Client(), "ws"+strings.TrimPrefix(srv.URL, "http"), header)
+	if err != nil {
+		t.Fatalf("Dial err:%v", err)
+	}
+	defer conn.Close()
+
+	// 覆盖 7 位、16 位、64 位三种长度编码
+	for _, size := range []int{0, 125, 126, 70000} {
+		data := bytes.Repeat([]byte{'a'}, size)
+		if err := conn.WriteMessage(BinaryMessage, data); err != nil {
+			t.Fatalf("WriteMessage err:%v", err)
+		}
+		typ, got, err := conn.ReadMessage()
+		if err != nil || typ != BinaryMessage || !bytes.Equal(got, data) {
+			t.Fatalf("size %d: type %d, %d bytes, err:%v", size, typ, len(got), err)
+		}
+	}
+
+	if err := conn.WriteMessage(TextMessage, []byte("close")); err != nil {
+		t.Fatalf("WriteMessage err:%v", err)
+	}
+	_, _, err = conn.ReadMessage()
+	var ce *CloseError
+	if !errors.As(err, &ce) || ce.Code != CloseInvalidPayload || ce.Reason != "bye" {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestDialBadHandshake(t *testing.T) {
+	srv := echoServer(t)
+
+	_, resp, err := Dial(context.TODO(), srv.Client(), srv.URL, nil)
+	if !errors.Is(err, ErrBadHandshake) || resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("resp = %v, err:%v", resp, err)
+	}
+	resp.Body.Close()
+}
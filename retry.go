@@ -0,0 +1,90 @@
+package go_micro_tts
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy 请求失败时的重试策略
+// 429 限流对任何请求都会重试；5xx 及网络错误仅重试幂等或安全的请求（获取令牌、语音列表、合成、查询及删除批处理任务）
+type RetryPolicy struct {
+	MaxAttempts        int           // 最大尝试次数（包含第一次请求），小于等于 1 时不重试
+	BaseDelay          time.Duration // 第一次重试前的等待时间，之后按指数增长
+	MaxDelay           time.Duration // 单次等待的最大时间，Retry-After 超出该值时不再重试
+	Jitter             float64       // 等待时间的随机抖动比例，取值 0~1
+	RetryNonIdempotent bool          // 5xx 及网络错误时是否也重试非幂等请求（创建批处理任务）
+}
+
+// DefaultRetryPolicy 推荐的重试策略
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    10 * time.Second,
+	Jitter:      0.2,
+}
+
+// WithRetryPolicy 设置请求失败时的重试策略，默认不重试
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(g *GoTTS) {
+		g.retryPolicy = policy
+	}
+}
+
+// retry 实现 internal.RetryFunc
+func (p RetryPolicy) retry(attempt int, idempotent bool, resp *http.Response, err error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts {
+		return 0, false
+	}
+
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return 0, false
+		}
+		if !idempotent && !p.RetryNonIdempotent {
+			return 0, false
+		}
+		return p.backoff(attempt), true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		if !idempotent && !p.RetryNonIdempotent {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	if d := retryAfter(resp.Header); d > 0 {
+		if p.MaxDelay > 0 && d > p.MaxDelay {
+			return 0, false
+		}
+		return d, true
+	}
+
+	return p.backoff(attempt), true
+}
+
+// backoff 第 attempt 次请求失败后的等待时间
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseDelay
+	for i := 1; i < attempt && (p.MaxDelay <= 0 || d < p.MaxDelay); i++ {
+		d *= 2
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+
+	if p.Jitter > 0 {
+		d += time.Duration((rand.Float64()*2 - 1) * p.Jitter * float64(d))
+	}
+	if d < 0 {
+		d = 0
+	}
+
+	return d
+}
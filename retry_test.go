@@ -0,0 +1,89 @@
+package go_micro_tts
+
+import (
+	"errors"
+	"github.com/xuemingjings/go-micro-tts/ttstest"
+	"net/http"
+	"testing"
+	"time"
+)
+
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   time.Millisecond,
+	MaxDelay:    10 * time.Millisecond,
+}
+
+func TestRetryThrottled(t *testing.T) {
+	tts, srv := newTestTTS(t)
+	tts.retryPolicy = testRetryPolicy
+	srv.Throttle(ttstest.PathSynthesis, 2, 0)
+
+	ssml := NewSpeakXml(&SpeakXmlReq{Lang: "zh-CN", Name: "zh-CN-YunxiNeural", Text: "你好"})
+	_, funcClose, err := tts.TextToVoice(Audio16kHz32KbitrateMonoMp3, ssml)
+	funcClose()
+	if err != nil {
+		t.Fatalf("重试后应成功 err:%v", err)
+	}
+	if n := srv.Requests(ttstest.PathSynthesis); n != 3 {
+		t.Errorf("合成请求次数 = %d, 期望 3", n)
+	}
+}
+
+func TestRetryExhausted(t *testing.T) {
+	tts, srv := newTestTTS(t)
+	tts.retryPolicy = testRetryPolicy
+	srv.Fail(ttstest.PathVoiceList, 5, http.StatusServiceUnavailable, "ServiceUnavailable", "")
+
+	_, err := tts.GetVoiceList()
+	if !errors.Is(err, ErrServer) {
+		t.Fatalf("err = %v, 期望 ErrServer", err)
+	}
+	if n := srv.Requests(ttstest.PathVoiceList); n != 3 {
+		t.Errorf("语音列表请求次数 = %d, 期望 3", n)
+	}
+}
+
+func TestRetryNonIdempotent(t *testing.T) {
+	tts, srv := newTestTTS(t)
+	tts.retryPolicy = testRetryPolicy
+	srv.Fail(ttstest.PathBatch, 1, http.StatusInternalServerError, "InternalServerError", "")
+
+	_, err := tts.LongTextToVoiceCreate(NewLongSpeak(&LongSpeakXmlReq{
+		DisplayName:  "不重试",
+		Inputs:       []*LongSpeakInputs{{Text: "你好"}},
+		OutputFormat: Audio16kHz32KbitrateMonoMp3,
+	}))
+	if !errors.Is(err, ErrServer) {
+		t.Fatalf("err = %v, 期望 ErrServer", err)
+	}
+	if n := srv.Requests(ttstest.PathBatch); n != 1 {
+		t.Errorf("创建任务请求次数 = %d, 期望 1", n)
+	}
+}
+
+func TestRetryAfterExceedsMaxDelay(t *testing.T) {
+	tts, srv := newTestTTS(t)
+	tts.retryPolicy = testRetryPolicy
+	srv.Throttle(ttstest.PathVoiceList, 1, 5*time.Second)
+
+	_, err := tts.GetVoiceList()
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.RetryAfter != 5*time.Second {
+		t.Fatalf("err = %v", err)
+	}
+	if n := srv.Requests(ttstest.PathVoiceList); n != 1 {
+		t.Errorf("语音列表请求次数 = %d, 期望 1", n)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 10, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second, Jitter: 0.2}
+
+	for attempt, want := range map[int]time.Duration{1: 100 * time.Millisecond, 2: 200 * time.Millisecond, 4: 800 * time.Millisecond, 8: time.Second} {
+		d := p.backoff(attempt)
+		if d < want*8/10 || d > want*12/10 {
+			t.Errorf("backoff(%d) = %v, 期望 %v±20%%", attempt, d, want)
+		}
+	}
+}
@@ -3,25 +3,42 @@ package go_micro_tts
 import "encoding/xml"
 
 type SpeakXmlReq struct {
-	Lang   string
-	Gender string
-	Name   string
+	Lang   string // 区域设置，为空时由 Name 推断
+	Gender string // 性别，为空时由 Name 推断
+	Name   string // 语音名称，如 string(VoiceZhCNYunxiNeural)，使用 Voice 常量时可调用 NewVoiceSpeakXml
 	Text   string
 }
 
+// NewSpeakXml 创建单个语音的 SSML
+// 未设置 Lang、Gender 时由 Name 推断，语音列表之外的语音按名称推断区域设置，如 zh-CN-XxxNeural 为 zh-CN
 func NewSpeakXml(req *SpeakXmlReq) *SpeakXml {
+	lang, gender := req.Lang, req.Gender
+	if voice := Voice(req.Name); voice != "" {
+		if lang == "" {
+			lang = string(voice.Locale())
+		}
+		if gender == "" {
+			gender = string(voice.Gender())
+		}
+	}
+
 	return &SpeakXml{
 		Version: "1.0",
-		Lang:    req.Lang,
+		Lang:    lang,
 		Voice: VoiceXml{
-			Lang:   req.Lang,
-			Gender: req.Gender,
+			Lang:   lang,
+			Gender: gender,
 			Name:   req.Name,
 			Text:   req.Text,
 		},
 	}
 }
 
+// NewVoiceSpeakXml 由语音及文本创建 SSML，区域设置及性别由语音推断
+func NewVoiceSpeakXml(voice Voice, text string) *SpeakXml {
+	return NewSpeakXml(&SpeakXmlReq{Name: string(voice), Text: text})
+}
+
 type LongSpeakXmlReq struct {
 	DisplayName             string             // 批处理合成的名称
 	Inputs                  []*LongSpeakInputs // 如果需要多个音频输出文件，则最多包含 1,000 个文本对象。
@@ -0,0 +1,309 @@
+package go_micro_tts
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	ssmlNamespace  = "http://www.w3.org/2001/10/synthesis"
+	msttsNamespace = "https://www.w3.org/2001/mstts"
+)
+
+// SsmlDocument 可提交给 TextToVoice 的 SSML 文档，*SpeakXml 与 *SsmlSpeak 均实现了该接口
+type SsmlDocument interface {
+	Ssml() ([]byte, error)
+}
+
+// Ssml 实现 SsmlDocument
+func (s *SpeakXml) Ssml() ([]byte, error) {
+	return xml.MarshalIndent(s, "", "    ")
+}
+
+// SsmlSpeak SSML 文档的根节点 <speak>
+// https://learn.microsoft.com/zh-cn/azure/ai-services/speech-service/speech-synthesis-markup-structure
+type SsmlSpeak struct {
+	Lang     string      // xml:lang，如 zh-CN
+	Children []*SsmlNode // 子节点，通常为一个或多个 <voice>
+}
+
+// SsmlNode SSML 元素或文本节点
+type SsmlNode struct {
+	Tag      string      // 元素名称，为空时表示文本节点
+	Attrs    []SsmlAttr  // 元素属性，值为空的属性不会输出
+	Text     string      // 文本节点的内容
+	Children []*SsmlNode // 子节点
+}
+
+// SsmlAttr SSML 元素属性
+type SsmlAttr struct {
+	Name  string
+	Value string
+}
+
+// NewSsmlSpeak 创建 SSML 文档
+//
+//	doc := NewSsmlSpeak("zh-CN").
+//		Voice("zh-CN-YunxiNeural",
+//			SsmlExpressAs(SsmlExpressAsAttr{Style: "cheerful"}, SsmlText("你好")),
+//			SsmlBreak(500*time.Millisecond),
+//			SsmlProsody(SsmlProsodyAttr{Rate: "+20%"}, SsmlText("很高兴认识你"))).
+//		Voice("zh-CN-XiaoxiaoNeural", SsmlText("我也是"))
+func NewSsmlSpeak(lang string) *SsmlSpeak {
+	return &SsmlSpeak{Lang: lang}
+}
+
+// Voice 追加一段由指定语音朗读的内容
+func (s *SsmlSpeak) Voice(name string, children ...*SsmlNode) *SsmlSpeak {
+	s.Children = append(s.Children, SsmlVoice(name, children...))
+	return s
+}
+
+// Add 追加子节点，如 mstts:backgroundaudio
+func (s *SsmlSpeak) Add(children ...*SsmlNode) *SsmlSpeak {
+	s.Children = append(s.Children, children...)
+	return s
+}
+
+// Ssml 实现 SsmlDocument
+func (s *SsmlSpeak) Ssml() ([]byte, error) {
+	if s.Lang == "" {
+		return nil, errors.New("ssml: the speak xml:lang is required")
+	}
+
+	root := &SsmlNode{
+		Tag: "speak",
+		Attrs: []SsmlAttr{
+			{Name: "version", Value: "1.0"},
+			{Name: "xmlns", Value: ssmlNamespace},
+			{Name: "xmlns:mstts", Value: msttsNamespace},
+			{Name: "xml:lang", Value: s.Lang},
+		},
+		Children: s.Children,
+	}
+
+	voices := 0
+	for _, v := range s.Children {
+		if v != nil && v.Tag == "voice" {
+			voices++
+		}
+	}
+	if voices == 0 {
+		return nil, errors.New("ssml: at least one voice is required")
+	}
+
+	buf := &bytes.Buffer{}
+	if err := root.write(buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// String 返回 SSML 文本，生成失败时返回空字符串
+func (s *SsmlSpeak) String() string {
+	data, _ := s.Ssml()
+	return string(data)
+}
+
+// Append 追加子节点
+func (n *SsmlNode) Append(children ...*SsmlNode) *SsmlNode {
+	n.Children = append(n.Children, children...)
+	return n
+}
+
+func (n *SsmlNode) write(buf *bytes.Buffer) error {
+	if n == nil {
+		return nil
+	}
+
+	if n.Tag == "" {
+		return xml.EscapeText(buf, []byte(n.Text))
+	}
+
+	if n.Tag == "voice" && attrValue(n.Attrs, "name") == "" {
+		return errors.New("ssml: the voice name is required")
+	}
+
+	buf.WriteString("<" + n.Tag)
+	for _, a := range n.Attrs {
+		if a.Value == "" {
+			continue
+		}
+		buf.WriteString(" " + a.Name + `="`)
+		if err := xml.EscapeText(buf, []byte(a.Value)); err != nil {
+			return err
+		}
+		buf.WriteString(`"`)
+	}
+
+	if len(n.Children) == 0 && n.Text == "" {
+		buf.WriteString("/>")
+		return nil
+	}
+	buf.WriteString(">")
+
+	if n.Text != "" {
+		if err := xml.EscapeText(buf, []byte(n.Text)); err != nil {
+			return err
+		}
+	}
+	for _, c := range n.Children {
+		if err := c.write(buf); err != nil {
+			return err
+		}
+	}
+
+	buf.WriteString("</" + n.Tag + ">")
+	return nil
+}
+
+func attrValue(attrs []SsmlAttr, name string) string {
+	for _, a := range attrs {
+		if a.Name == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func newSsmlNode(tag string, attrs []SsmlAttr, children []*SsmlNode) *SsmlNode {
+	return &SsmlNode{Tag: tag, Attrs: attrs, Children: children}
+}
+
+// SsmlText 文本节点，特殊字符会被转义
+func SsmlText(text string) *SsmlNode {
+	return &SsmlNode{Text: text}
+}
+
+// SsmlVoice <voice> 指定朗读的语音
+func SsmlVoice(name string, children ...*SsmlNode) *SsmlNode {
+	return newSsmlNode("voice", []SsmlAttr{{Name: "name", Value: name}}, children)
+}
+
+// SsmlProsodyAttr <prosody> 的属性
+type SsmlProsodyAttr struct {
+	Rate    string // 语速，如 "+20%"、"1.5"、"slow"
+	Pitch   string // 音调，如 "+10Hz"、"-5%"、"high"
+	Volume  string // 音量，如 "+20%"、"80"、"loud"
+	Contour string // 音调曲线，如 "(0%,+20Hz) (50%,-10Hz)"
+	Range   string // 音调范围
+}
+
+// SsmlProsody <prosody> 调整语速、音调、音量
+func SsmlProsody(attr SsmlProsodyAttr, children ...*SsmlNode) *SsmlNode {
+	return newSsmlNode("prosody", []SsmlAttr{
+		{Name: "rate", Value: attr.Rate},
+		{Name: "pitch", Value: attr.Pitch},
+		{Name: "volume", Value: attr.Volume},
+		{Name: "contour", Value: attr.Contour},
+		{Name: "range", Value: attr.Range},
+	}, children)
+}
+
+// SsmlBreak <break time> 插入指定时长的停顿
+func SsmlBreak(d time.Duration) *SsmlNode {
+	return newSsmlNode("break", []SsmlAttr{{Name: "time", Value: ssmlDuration(d)}}, nil)
+}
+
+// SsmlBreakStrength <break strength> 插入指定强度的停顿：x-weak、weak、medium、strong、x-strong
+func SsmlBreakStrength(strength string) *SsmlNode {
+	return newSsmlNode("break", []SsmlAttr{{Name: "strength", Value: strength}}, nil)
+}
+
+// SsmlSilence <mstts:silence> 在文本前后或句子间插入静音
+// silenceType 取值 Leading、Tailing、Sentenceboundary 等
+func SsmlSilence(silenceType string, d time.Duration) *SsmlNode {
+	return newSsmlNode("mstts:silence", []SsmlAttr{
+		{Name: "type", Value: silenceType},
+		{Name: "value", Value: ssmlDuration(d)},
+	}, nil)
+}
+
+// SsmlEmphasis <emphasis> 强调，level 取值 reduced、none、moderate、strong
+func SsmlEmphasis(level string, children ...*SsmlNode) *SsmlNode {
+	return newSsmlNode("emphasis", []SsmlAttr{{Name: "level", Value: level}}, children)
+}
+
+// SsmlSayAs <say-as> 指定文本的读法，如 interpretAs 为 date、cardinal、telephone
+func SsmlSayAs(interpretAs, format, text string) *SsmlNode {
+	n := newSsmlNode("say-as", []SsmlAttr{
+		{Name: "interpret-as", Value: interpretAs},
+		{Name: "format", Value: format},
+	}, nil)
+	n.Text = text
+	return n
+}
+
+// SsmlPhoneme <phoneme> 指定发音，alphabet 取值 ipa、sapi、ups、x-microsoft-sapi
+func SsmlPhoneme(alphabet, ph, text string) *SsmlNode {
+	n := newSsmlNode("phoneme", []SsmlAttr{
+		{Name: "alphabet", Value: alphabet},
+		{Name: "ph", Value: ph},
+	}, nil)
+	n.Text = text
+	return n
+}
+
+// SsmlSub <sub> 用 alias 的读法替换 text
+func SsmlSub(alias, text string) *SsmlNode {
+	n := newSsmlNode("sub", []SsmlAttr{{Name: "alias", Value: alias}}, nil)
+	n.Text = text
+	return n
+}
+
+// SsmlExpressAsAttr <mstts:express-as> 的属性
+type SsmlExpressAsAttr struct {
+	Style       string  // 说话风格，如 cheerful、sad，参见 VoiceList.StyleList
+	Role        string  // 角色扮演，如 Boy、OlderAdultFemale，参见 VoiceList.RolePlayList
+	StyleDegree float64 // 风格强度 0.01~2，为 0 时使用默认值 1
+}
+
+// SsmlExpressAs <mstts:express-as> 设置说话风格及角色
+func SsmlExpressAs(attr SsmlExpressAsAttr, children ...*SsmlNode) *SsmlNode {
+	degree := ""
+	if attr.StyleDegree != 0 {
+		degree = strconv.FormatFloat(attr.StyleDegree, 'f', -1, 64)
+	}
+	return newSsmlNode("mstts:express-as", []SsmlAttr{
+		{Name: "style", Value: attr.Style},
+		{Name: "styledegree", Value: degree},
+		{Name: "role", Value: attr.Role},
+	}, children)
+}
+
+// SsmlLang <lang> 多语言语音切换朗读的语言
+func SsmlLang(lang string, children ...*SsmlNode) *SsmlNode {
+	return newSsmlNode("lang", []SsmlAttr{{Name: "xml:lang", Value: lang}}, children)
+}
+
+// SsmlAudio <audio> 插入音频文件，children 为音频不可用时朗读的内容
+func SsmlAudio(src string, children ...*SsmlNode) *SsmlNode {
+	return newSsmlNode("audio", []SsmlAttr{{Name: "src", Value: src}}, children)
+}
+
+// SsmlBookmark <bookmark> 书签，合成时触发 Bookmark 事件
+func SsmlBookmark(mark string) *SsmlNode {
+	return newSsmlNode("bookmark", []SsmlAttr{{Name: "mark", Value: mark}}, nil)
+}
+
+// SsmlParagraph <p> 段落
+func SsmlParagraph(children ...*SsmlNode) *SsmlNode {
+	return newSsmlNode("p", nil, children)
+}
+
+// SsmlSentence <s> 句子
+func SsmlSentence(children ...*SsmlNode) *SsmlNode {
+	return newSsmlNode("s", nil, children)
+}
+
+// ssmlDuration 时长转为 SSML 的时间格式，如 500ms、2s
+func ssmlDuration(d time.Duration) string {
+	if d%time.Second == 0 {
+		return fmt.Sprintf("%ds", d/time.Second)
+	}
+	return fmt.Sprintf("%dms", d/time.Millisecond)
+}
@@ -0,0 +1,153 @@
+package go_micro_tts
+
+import (
+	"fmt"
+	"github.com/xuemingjings/go-micro-tts/audio"
+	"path/filepath"
+	"strings"
+)
+
+// ssmlOuts 全部输出格式，顺序与 define.go 一致
+var ssmlOuts = []SsmlOut{
+	AmrWb16000Hz,
+	Audio16kHz16Bit32kbpsMonoOpus,
+	Audio16kHz32KbitrateMonoMp3,
+	Audio16kHz64KbitrateMonoMp3,
+	Audio16kHz128KbitrateMonoMp3,
+	Audio24kHz16Bit24kbpsMonoOpus,
+	Audio24kHz16Bit48kbpsMonoOpus,
+	Audio24kHz48KbitrateMonoMp3,
+	Audio24kHz96KbitrateMonoMp3,
+	Audio24kHz160KbitrateMonoMp3,
+	Audio48kHz96KbitrateMonoMp3,
+	Audio48kHz192KbitrateMonoMp3,
+	Ogg16kHz16BitMonoOpus,
+	Ogg24kHz16BitMonoOpus,
+	Ogg48kHz16BitMonoOpus,
+	Raw8kHz8BitMonoAlaw,
+	Raw8kHz8BitMonoMulaw,
+	Raw8kHz16BitMonoPcm,
+	Raw16kHz16BitMonoPcm,
+	Raw16kHz16BitMonoTruesilk,
+	Raw22050Hz16BitMonoPcm,
+	Raw24kHz16BitMonoPcm,
+	Raw24kHz16BitMonoTruesilk,
+	Raw44100Hz16BitMonoPcm,
+	Raw48kHz16BitMonoPcm,
+	Riff8kHz8BitMonoAlaw,
+	Riff8kHz8BitMonoMulaw,
+	Riff8kHz16BitMonoPcm,
+	Riff16kHz16BitMonoPcm,
+	Riff22050Hz16BitMonoPcm,
+	Riff24kHz16BitMonoPcm,
+	Riff44100Hz16BitMonoPcm,
+	Riff48kHz16BitMonoPcm,
+	Webm16kHz16BitMonoOpus,
+	Webm24kHz16Bit24kbpsMonoOpus,
+	Webm24kHz16BitMonoOpus,
+}
+
+// SsmlOutInfo 输出格式的信息
+type SsmlOutInfo struct {
+	Format        SsmlOut         // 输出格式
+	Container     audio.Container // 容器格式，如 mp3、ogg、riff
+	Codec         audio.Codec     // 编码，如 mp3、opus、pcm
+	SampleRate    int             // 采样率（Hz）
+	BitsPerSample int             // 位深，未注明时为 0
+	Channels      int             // 声道数
+	Bitrate       int             // 码率（bit/s），未注明时为 0
+	MimeType      string          // MIME 类型，如 audio/mpeg
+	Extension     string          // 文件扩展名，如 .mp3
+}
+
+// SsmlOuts 返回全部输出格式
+func SsmlOuts() []SsmlOut {
+	return append([]SsmlOut(nil), ssmlOuts...)
+}
+
+// ParseSsmlOut 解析输出格式名称，不区分大小写，只接受 SsmlOuts 中的格式
+// 不支持的格式返回的错误可通过 errors.Is(err, audio.ErrUnsupported) 判断
+func ParseSsmlOut(s string) (SsmlOut, error) {
+	name := SsmlOut(strings.ToLower(strings.TrimSpace(s)))
+	for _, o := range ssmlOuts {
+		if o == name {
+			return o, nil
+		}
+	}
+	return "", fmt.Errorf("parse output format %q: %w", s, audio.ErrUnsupported)
+}
+
+// Info 解析输出格式的容器、编码、采样率等信息，无法解析时只有 Format 字段
+//
+//	info := Audio24kHz48KbitrateMonoMp3.Info()
+//	// info.SampleRate == 24000, info.Bitrate == 48000, info.MimeType == "audio/mpeg", info.Extension == ".mp3"
+func (o SsmlOut) Info() SsmlOutInfo {
+	f, err := audio.ParseFormat(string(o))
+	if err != nil {
+		return SsmlOutInfo{Format: o}
+	}
+	return SsmlOutInfo{
+		Format:        o,
+		Container:     f.Container,
+		Codec:         f.Codec,
+		SampleRate:    f.SampleRate,
+		BitsPerSample: f.BitsPerSample,
+		Channels:      f.Channels,
+		Bitrate:       f.Bitrate,
+		MimeType:      f.MimeType(),
+		Extension:     f.Extension(),
+	}
+}
+
+// FileName 按输出格式补全文件扩展名，name 已有扩展名时原样返回，如 FileName("out") 为 out.mp3
+// TextToVoiceFile、SynthesizeLongFile、DownloadResults 写入文件时同样补全扩展名，实际写入的路径即 FileName 的返回值
+func (o SsmlOut) FileName(name string) string {
+	if filepath.Ext(name) != "" {
+		return name
+	}
+	return name + o.Info().Extension
+}
+
+// SsmlOutQuery 查找输出格式的条件，字段为零值时表示不限
+type SsmlOutQuery struct {
+	Container  audio.Container
+	Codec      audio.Codec
+	SampleRate int // 采样率（Hz）
+	MinBitrate int // 最低码率（bit/s）
+	MaxBitrate int // 最高码率（bit/s），用于限制带宽
+}
+
+// FindSsmlOut 查找符合条件的最佳输出格式：码率最高，其次采样率最高
+//
+//	// 24kHz 下音质最好的 mp3：audio-24khz-160kbitrate-mono-mp3
+//	format, ok := FindSsmlOut(SsmlOutQuery{Codec: audio.CodecMP3, SampleRate: 24000})
+func FindSsmlOut(q SsmlOutQuery) (SsmlOut, bool) {
+	var best SsmlOutInfo
+	found := false
+	for _, o := range ssmlOuts {
+		info := o.Info()
+		if !q.match(info) {
+			continue
+		}
+		if !found || info.Bitrate > best.Bitrate || (info.Bitrate == best.Bitrate && info.SampleRate > best.SampleRate) {
+			best, found = info, true
+		}
+	}
+	return best.Format, found
+}
+
+func (q SsmlOutQuery) match(info SsmlOutInfo) bool {
+	switch {
+	case q.Container != "" && info.Container != q.Container:
+		return false
+	case q.Codec != "" && info.Codec != q.Codec:
+		return false
+	case q.SampleRate != 0 && info.SampleRate != q.SampleRate:
+		return false
+	case q.MinBitrate != 0 && info.Bitrate < q.MinBitrate:
+		return false
+	case q.MaxBitrate != 0 && (info.Bitrate == 0 || info.Bitrate > q.MaxBitrate):
+		return false
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+package go_micro_tts
+
+import (
+	"errors"
+	"github.com/xuemingjings/go-micro-tts/audio"
+	"testing"
+)
+
+func TestSsmlOutInfo(t *testing.T) {
+	for _, o := range SsmlOuts() {
+		info := o.Info()
+		if info.Container == "" || info.Codec == "" || info.SampleRate == 0 || info.MimeType == "" || info.Extension == "" {
+			t.Errorf("%s info = %+v", o, info)
+		}
+	}
+
+	info := Audio24kHz48KbitrateMonoMp3.Info()
+	want := SsmlOutInfo{
+		Format:     Audio24kHz48KbitrateMonoMp3,
+		Container:  audio.ContainerMP3,
+		Codec:      audio.CodecMP3,
+		SampleRate: 24000,
+		Channels:   1,
+		Bitrate:    48000,
+		MimeType:   "audio/mpeg",
+		Extension:  ".mp3",
+	}
+	if info != want {
+		t.Errorf("info = %+v", info)
+	}
+
+	info = Riff16kHz16BitMonoPcm.Info()
+	if info.BitsPerSample != 16 || info.Bitrate != 256000 || info.MimeType != "audio/wav" || info.Extension != ".wav" {
+		t.Errorf("riff info = %+v", info)
+	}
+
+	if info := SsmlOut("unknown").Info(); info != (SsmlOutInfo{Format: "unknown"}) {
+		t.Errorf("unknown info = %+v", info)
+	}
+}
+
+func TestParseSsmlOut(t *testing.T) {
+	o, err := ParseSsmlOut(" Audio-24khz-48kbitrate-mono-MP3 ")
+	if err != nil || o != Audio24kHz48KbitrateMonoMp3 {
+		t.Errorf("o = %s, err = %v", o, err)
+	}
+	if _, err := ParseSsmlOut("mp3"); !errors.Is(err, audio.ErrUnsupported) {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestFindSsmlOut(t *testing.T) {
+	tests := []struct {
+		q    SsmlOutQuery
+		want SsmlOut
+	}{
+		{SsmlOutQuery{Codec: audio.CodecMP3, SampleRate: 24000}, Audio24kHz160KbitrateMonoMp3},
+		{SsmlOutQuery{Codec: audio.CodecMP3}, Audio48kHz192KbitrateMonoMp3},
+		{SsmlOutQuery{Codec: audio.CodecMP3, MaxBitrate: 64000}, Audio16kHz64KbitrateMonoMp3},
+		{SsmlOutQuery{Container: audio.ContainerRIFF, Codec: audio.CodecPCM}, Riff48kHz16BitMonoPcm},
+		{SsmlOutQuery{Container: audio.ContainerRaw, Codec: audio.CodecMuLaw}, Raw8kHz8BitMonoMulaw},
+	}
+	for _, tt := range tests {
+		if got, ok := FindSsmlOut(tt.q); !ok || got != tt.want {
+			t.Errorf("FindSsmlOut(%+v) = %s, 期望 %s", tt.q, got, tt.want)
+		}
+	}
+	if _, ok := FindSsmlOut(SsmlOutQuery{Codec: audio.CodecMP3, SampleRate: 8000}); ok {
+		t.Error("不存在的格式应返回 false")
+	}
+}
+
+func TestSsmlOutFileName(t *testing.T) {
+	if name := Ogg24kHz16BitMonoOpus.FileName("out"); name != "out.ogg" {
+		t.Errorf("name = %s", name)
+	}
+	if name := Ogg24kHz16BitMonoOpus.FileName("out.opus"); name != "out.opus" {
+		t.Errorf("name = %s", name)
+	}
+}
+
+func TestParseSsmlOutStrict(t *testing.T) {
+	for _, s := range []string{"audio-24khz-48kbitrate-mono-pcm", "riff-7khz-mp3", "ogg-96khz-16bit-stereo-alaw", "raw-24khz-16bit-mono-pcm-pcm", "riff-96khz-16bit-mono-pcm"} {
+		if _, err := ParseSsmlOut(s); !errors.Is(err, audio.ErrUnsupported) {
+			t.Errorf("ParseSsmlOut(%q) err = %v", s, err)
+		}
+	}
+	for _, o := range SsmlOuts() {
+		if got, err := ParseSsmlOut(string(o)); err != nil || got != o {
+			t.Errorf("ParseSsmlOut(%s) = %s, %v", o, got, err)
+		}
+	}
+}
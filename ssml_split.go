@@ -0,0 +1,294 @@
+package go_micro_tts
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultSsmlChunkSize SplitSSML 默认每段的最大字数，按中文每分钟约 250 字估算，约 6 分钟音频，低于 REST 接口 10 分钟的限制
+const DefaultSsmlChunkSize = 1500
+
+// ssmlAtomicTags 内容不可拆分的元素
+var ssmlAtomicTags = map[string]bool{
+	"say-as":  true,
+	"phoneme": true,
+	"sub":     true,
+	"audio":   true,
+	"math":    true,
+}
+
+// ssmlBoundaryTags 结束后可作为拆分点的元素
+var ssmlBoundaryTags = map[string]bool{
+	"p":     true,
+	"s":     true,
+	"voice": true,
+}
+
+// SplitSSML 按句子及段落将 SSML 拆分为多段，每段的文本不超过 maxChars 个字（maxChars <= 0 时为 DefaultSsmlChunkSize）
+// 拆分处会闭合所有未结束的元素，并在下一段重新打开（如 <speak>、<voice>、<prosody>），因此每段都是完整的 SSML 文档
+// 拆分点优先选择句末标点（。！？；.!?;）及 </p>、</s>、</voice>，单句过长时再按逗号、空格拆分
+// 根元素之前的 XML 声明、注释等会写入每一段，根元素内的注释及处理指令原样保留；只允许一个根元素
+func SplitSSML(ssml []byte, maxChars int) ([][]byte, error) {
+	if maxChars <= 0 {
+		maxChars = DefaultSsmlChunkSize
+	}
+
+	sp := &ssmlSplitter{max: maxChars}
+	dec := xml.NewDecoder(bytes.NewReader(ssml))
+	for {
+		tok, err := dec.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		root := len(sp.stack) == 0
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if root && sp.rooted {
+				return nil, errors.New("ssml: multiple root elements <" + xmlName(t.Name) + ">")
+			}
+			sp.rooted = true
+			sp.start(t)
+		case xml.EndElement:
+			if err := sp.end(t); err != nil {
+				return nil, err
+			}
+		case xml.CharData:
+			if root {
+				if len(bytes.TrimSpace(t)) > 0 {
+					return nil, errors.New("ssml: text outside the root element")
+				}
+				continue
+			}
+			sp.text(string(t))
+		case xml.Comment, xml.ProcInst, xml.Directive:
+			if !sp.rooted {
+				writeToken(&sp.prolog, t)
+			} else {
+				writeToken(&sp.buf, t)
+			}
+		}
+	}
+	if len(sp.stack) > 0 || !sp.rooted {
+		return nil, errors.New("ssml: unexpected EOF")
+	}
+
+	if sp.size > 0 || len(sp.chunks) == 0 {
+		sp.chunks = append(sp.chunks, sp.buf.Bytes())
+	}
+	if sp.prolog.Len() > 0 {
+		for i, chunk := range sp.chunks {
+			sp.chunks[i] = append(bytes.Clone(sp.prolog.Bytes()), chunk...)
+		}
+	}
+	return sp.chunks, nil
+}
+
+// ssmlSplitter 记录最近的拆分点，文本超出上限时在该处拆分
+type ssmlSplitter struct {
+	max    int
+	chunks [][]byte
+	prolog bytes.Buffer       // 根元素之前的 XML 声明、注释等
+	rooted bool               // 是否已读到根元素
+	buf    bytes.Buffer       // 当前段
+	size   int                // 当前段的字数
+	stack  []xml.StartElement // 未结束的元素
+	atomic int                // 所在的不可拆分元素的层数
+	mark   int                // 最近拆分点在 buf 中的位置
+	marked []xml.StartElement // 拆分点处未结束的元素
+	mSize  int                // 拆分点之前的字数
+}
+
+func (sp *ssmlSplitter) start(t xml.StartElement) {
+	if ssmlAtomicTags[t.Name.Local] {
+		sp.atomic++
+	}
+	writeStartElement(&sp.buf, t)
+	sp.stack = append(sp.stack, t.Copy())
+}
+
+func (sp *ssmlSplitter) end(t xml.EndElement) error {
+	if len(sp.stack) == 0 || sp.stack[len(sp.stack)-1].Name != t.Name {
+		return errors.New("ssml: unexpected end element </" + xmlName(t.Name) + ">")
+	}
+	if ssmlAtomicTags[t.Name.Local] {
+		sp.atomic--
+	}
+	sp.buf.WriteString("</" + xmlName(t.Name) + ">")
+	sp.stack = sp.stack[:len(sp.stack)-1]
+
+	if ssmlBoundaryTags[t.Name.Local] && sp.atomic == 0 {
+		sp.setMark()
+	}
+	return nil
+}
+
+func (sp *ssmlSplitter) text(s string) {
+	if sp.atomic > 0 {
+		sp.write(s)
+		return
+	}
+
+	for _, sentence := range splitSentences(s) {
+		if utf8.RuneCountInString(sentence) <= sp.max {
+			sp.write(sentence)
+			sp.markAfter(sentence)
+			continue
+		}
+		// 单句超出上限时按子句拆分，每个子句之后均可拆分
+		for _, clause := range splitClauses(sentence, sp.max) {
+			sp.write(clause)
+			sp.setMark()
+		}
+	}
+}
+
+// write 写入文本，超出上限时在最近的拆分点拆分
+func (sp *ssmlSplitter) write(s string) {
+	_ = xml.EscapeText(&sp.buf, []byte(s))
+	sp.size += utf8.RuneCountInString(strings.TrimSpace(s))
+
+	if sp.size > sp.max && sp.mSize > 0 && sp.mSize < sp.size {
+		sp.cut()
+	}
+}
+
+// markAfter 文本以句末标点结束时设置拆分点
+func (sp *ssmlSplitter) markAfter(s string) {
+	s = strings.TrimRightFunc(s, func(r rune) bool { return unicode.IsSpace(r) || isClosingQuote(r) })
+	if r, _ := utf8.DecodeLastRuneInString(s); isSentenceEnd(r) {
+		sp.setMark()
+	}
+}
+
+func (sp *ssmlSplitter) setMark() {
+	sp.mark = sp.buf.Len()
+	sp.marked = append(sp.marked[:0], sp.stack...)
+	sp.mSize = sp.size
+}
+
+// cut 在拆分点处结束当前段，并在下一段重新打开拆分点处未结束的元素
+func (sp *ssmlSplitter) cut() {
+	chunk := &bytes.Buffer{}
+	chunk.Write(sp.buf.Bytes()[:sp.mark])
+	for i := len(sp.marked) - 1; i >= 0; i-- {
+		chunk.WriteString("</" + xmlName(sp.marked[i].Name) + ">")
+	}
+	sp.chunks = append(sp.chunks, chunk.Bytes())
+
+	rest := &bytes.Buffer{}
+	for _, t := range sp.marked {
+		writeStartElement(rest, t)
+	}
+	rest.Write(sp.buf.Bytes()[sp.mark:])
+	sp.buf = *rest
+	sp.size -= sp.mSize
+	sp.mark, sp.marked, sp.mSize = 0, nil, 0
+}
+
+func writeStartElement(buf *bytes.Buffer, t xml.StartElement) {
+	buf.WriteString("<" + xmlName(t.Name))
+	for _, a := range t.Attr {
+		buf.WriteString(" " + xmlName(a.Name) + `="`)
+		_ = xml.EscapeText(buf, []byte(a.Value))
+		buf.WriteString(`"`)
+	}
+	buf.WriteString(">")
+}
+
+// writeToken 写入注释、处理指令及 DOCTYPE 等声明
+func writeToken(buf *bytes.Buffer, tok xml.Token) {
+	switch t := tok.(type) {
+	case xml.Comment:
+		buf.WriteString("<!--")
+		buf.Write(t)
+		buf.WriteString("-->")
+	case xml.ProcInst:
+		buf.WriteString("<?" + t.Target)
+		if len(t.Inst) > 0 {
+			buf.WriteString(" ")
+			buf.Write(t.Inst)
+		}
+		buf.WriteString("?>")
+	case xml.Directive:
+		buf.WriteString("<!")
+		buf.Write(t)
+		buf.WriteString(">")
+	}
+}
+
+func isSentenceEnd(r rune) bool {
+	return strings.ContainsRune("。！？；…!?;.\n", r)
+}
+
+func isClosingQuote(r rune) bool {
+	return strings.ContainsRune(`"'”’」』）)`, r)
+}
+
+// splitSentences 按句末标点拆分文本，标点后的引号、括号及空白归入前一句
+// 英文句号仅在其后为空白或文本结尾时视为句末，以免拆开 3.14、e.g 等
+func splitSentences(s string) []string {
+	var res []string
+	runes := []rune(s)
+	start := 0
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if !isSentenceEnd(r) || (r == '.' && i+1 < len(runes) && !unicode.IsSpace(runes[i+1])) {
+			continue
+		}
+		for i+1 < len(runes) && (isSentenceEnd(runes[i+1]) || isClosingQuote(runes[i+1]) || unicode.IsSpace(runes[i+1])) {
+			i++
+		}
+		res = append(res, string(runes[start:i+1]))
+		start = i + 1
+	}
+	if start < len(runes) {
+		res = append(res, string(runes[start:]))
+	}
+	return res
+}
+
+// splitClauses 将过长的句子按逗号、空格拆分并合并为不超过 max 个字的子句，仍然过长时按字数拆分
+func splitClauses(s string, max int) []string {
+	var parts []string
+	runes := []rune(s)
+	start := 0
+	for i, r := range runes {
+		if strings.ContainsRune("，、：,:", r) || unicode.IsSpace(r) {
+			parts = append(parts, string(runes[start:i+1]))
+			start = i + 1
+		}
+	}
+	if start < len(runes) {
+		parts = append(parts, string(runes[start:]))
+	}
+
+	var res []string
+	cur, n := "", 0
+	for _, p := range parts {
+		pn := utf8.RuneCountInString(p)
+		if n > 0 && n+pn > max {
+			res = append(res, cur)
+			cur, n = "", 0
+		}
+		for pn > max {
+			pr := []rune(p)
+			res = append(res, string(pr[:max]))
+			p, pn = string(pr[max:]), pn-max
+		}
+		cur += p
+		n += pn
+	}
+	if cur != "" {
+		res = append(res, cur)
+	}
+	return res
+}
@@ -0,0 +1,135 @@
+package go_micro_tts
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSplitSSML(t *testing.T) {
+	doc := NewSsmlSpeak("zh-CN").Voice("zh-CN-XiaoxiaoNeural",
+		SsmlProsody(SsmlProsodyAttr{Rate: "+10%"},
+			SsmlText("今天天气很好。我们去公园吧！"),
+			SsmlSayAs("date", "ymd", "2024-01-02"),
+			SsmlText("好的？Hello world. It is 3.14 now.")))
+	ssml, _ := doc.Ssml()
+
+	chunks, err := SplitSSML(ssml, 12)
+	if err != nil {
+		t.Fatalf("拆分报错 err:%v", err)
+	}
+	if len(chunks) < 3 {
+		t.Fatalf("chunks = %d", len(chunks))
+	}
+
+	var text strings.Builder
+	for i, chunk := range chunks {
+		s := string(chunk)
+		if err := ValidateSSML(chunk, nil); err != nil {
+			t.Errorf("第 %d 段无效 err:%v\n%s", i, err, s)
+		}
+		if !strings.Contains(s, `<prosody rate="+10%">`) || !strings.HasSuffix(s, "</prosody></voice></speak>") {
+			t.Errorf("第 %d 段未闭合或未重新打开元素: %s", i, s)
+		}
+		text.WriteString(plainSsmlText(t, chunk))
+	}
+	if text.String() != plainSsmlText(t, ssml) {
+		t.Errorf("拆分后文本不一致: %q", text.String())
+	}
+	if !strings.Contains(string(chunks[0]), "今天天气很好。") || strings.Contains(string(chunks[0]), "3.14") {
+		t.Errorf("chunks[0] = %s", chunks[0])
+	}
+
+	// 不可拆分的元素保持完整，3.14 不在句号处拆开
+	for _, chunk := range chunks {
+		s := string(chunk)
+		if strings.Contains(s, "<say-as") != strings.Contains(s, "2024-01-02</say-as>") {
+			t.Errorf("say-as 被拆开: %s", s)
+		}
+		if text := plainSsmlText(t, chunk); strings.Contains(text, "3.") && !strings.Contains(text, "3.14") {
+			t.Errorf("3.14 被拆开: %s", s)
+		}
+	}
+
+	// 未超出上限时不拆分
+	if chunks, _ := SplitSSML(ssml, 0); len(chunks) != 1 {
+		t.Errorf("未超出上限时 chunks = %d", len(chunks))
+	}
+	if _, err := SplitSSML([]byte("<speak><voice>abc</speak>"), 0); err == nil {
+		t.Error("格式错误时应返回错误")
+	}
+}
+
+func TestSplitSSMLLongSentence(t *testing.T) {
+	doc := NewSsmlSpeak("en-US").Voice("en-US-JennyNeural", SsmlText(strings.Repeat("word ", 20)+strings.Repeat("x", 30)))
+	ssml, _ := doc.Ssml()
+
+	chunks, err := SplitSSML(ssml, 16)
+	if err != nil {
+		t.Fatalf("拆分报错 err:%v", err)
+	}
+	var text strings.Builder
+	for _, chunk := range chunks {
+		s := plainSsmlText(t, chunk)
+		if utf8.RuneCountInString(strings.TrimSpace(s)) > 16 {
+			t.Errorf("段落超出上限: %q", s)
+		}
+		text.WriteString(s)
+	}
+	if text.String() != plainSsmlText(t, ssml) {
+		t.Errorf("拆分后文本不一致: %q", text.String())
+	}
+}
+
+func TestSplitSentences(t *testing.T) {
+	got := splitSentences(`他说：“你好。”然后走了！Mr. Smith? e.g.x Yes`)
+	want := []string{"他说：“你好。”", "然后走了！", "Mr. ", "Smith? ", "e.g.x Yes"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("splitSentences = %q", got)
+	}
+}
+
+// plainSsmlText 提取 SSML 中的文本
+func plainSsmlText(t *testing.T, ssml []byte) string {
+	t.Helper()
+	var sb strings.Builder
+	inTag := false
+	for _, r := range string(ssml) {
+		switch {
+		case r == '<':
+			inTag = true
+		case r == '>':
+			inTag = false
+		case !inTag:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+func TestSplitSSMLRoot(t *testing.T) {
+	if _, err := SplitSSML([]byte(`<speak>a</speak><speak>b</speak>`), 0); err == nil {
+		t.Error("多个根元素应报错")
+	}
+	if _, err := SplitSSML([]byte(`<speak>a</speak>b`), 0); err == nil {
+		t.Error("根元素之外的文本应报错")
+	}
+
+	// 注释、XML 声明及处理指令不会丢失，根元素之前的部分写入每一段
+	ssml := `<?xml version="1.0"?><!-- 前言 --><speak><p>第一句。</p><!-- 注释 --><p>第二句。</p></speak>`
+	chunks, err := SplitSSML([]byte(ssml), 4)
+	if err != nil {
+		t.Fatalf("拆分报错 err:%v", err)
+	}
+	if len(chunks) != 2 {
+		t.Fatalf("chunks = %q", chunks)
+	}
+	for i, chunk := range chunks {
+		if !strings.HasPrefix(string(chunk), `<?xml version="1.0"?><!-- 前言 --><speak>`) {
+			t.Errorf("第 %d 段缺少声明: %s", i, chunk)
+		}
+	}
+	if !strings.Contains(string(chunks[0])+string(chunks[1]), "<!-- 注释 -->") {
+		t.Errorf("注释丢失: %q", chunks)
+	}
+}
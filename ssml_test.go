@@ -0,0 +1,82 @@
+package go_micro_tts
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSsmlSpeak(t *testing.T) {
+	doc := NewSsmlSpeak("zh-CN").
+		Voice("zh-CN-YunxiNeural",
+			SsmlExpressAs(SsmlExpressAsAttr{Style: "cheerful", Role: "Boy", StyleDegree: 1.5}, SsmlText("你好 & 欢迎")),
+			SsmlBreak(500*time.Millisecond),
+			SsmlProsody(SsmlProsodyAttr{Rate: "+20%", Pitch: "-5%"}, SsmlText("很高兴认识你")),
+			SsmlSayAs("date", "ymd", "2023-10-01"),
+		).
+		Voice("en-US-JennyNeural",
+			SsmlLang("en-US", SsmlEmphasis("strong", SsmlText("Hi"))),
+			SsmlSub("World Wide Web Consortium", "W3C"),
+			SsmlPhoneme("ipa", "təˈmeɪtoʊ", "tomato"),
+			SsmlBreak(2*time.Second),
+		)
+
+	got := doc.String()
+	want := `<speak version="1.0" xmlns="http://www.w3.org/2001/10/synthesis" xmlns:mstts="https://www.w3.org/2001/mstts" xml:lang="zh-CN">` +
+		`<voice name="zh-CN-YunxiNeural">` +
+		`<mstts:express-as style="cheerful" styledegree="1.5" role="Boy">你好 &amp; 欢迎</mstts:express-as>` +
+		`<break time="500ms"/>` +
+		`<prosody rate="+20%" pitch="-5%">很高兴认识你</prosody>` +
+		`<say-as interpret-as="date" format="ymd">2023-10-01</say-as>` +
+		`</voice>` +
+		`<voice name="en-US-JennyNeural">` +
+		`<lang xml:lang="en-US"><emphasis level="strong">Hi</emphasis></lang>` +
+		`<sub alias="World Wide Web Consortium">W3C</sub>` +
+		`<phoneme alphabet="ipa" ph="təˈmeɪtoʊ">tomato</phoneme>` +
+		`<break time="2s"/>` +
+		`</voice></speak>`
+	if got != want {
+		t.Fatalf("got  %s\nwant %s", got, want)
+	}
+
+	// 输出必须是格式正确的 XML
+	d := xml.NewDecoder(strings.NewReader(got))
+	for {
+		if _, err := d.Token(); err != nil {
+			if err != io.EOF {
+				t.Fatalf("SSML 格式错误 err:%v", err)
+			}
+			break
+		}
+	}
+}
+
+func TestSsmlSpeakInvalid(t *testing.T) {
+	if _, err := NewSsmlSpeak("zh-CN").Ssml(); err == nil {
+		t.Error("没有 voice 时应报错")
+	}
+	if _, err := NewSsmlSpeak("").Voice("zh-CN-YunxiNeural", SsmlText("你好")).Ssml(); err == nil {
+		t.Error("没有 xml:lang 时应报错")
+	}
+	if _, err := NewSsmlSpeak("zh-CN").Voice("", SsmlText("你好")).Ssml(); err == nil {
+		t.Error("voice 没有 name 时应报错")
+	}
+}
+
+func TestTextToVoiceSsmlSpeak(t *testing.T) {
+	tts, _ := newTestTTS(t)
+
+	doc := NewSsmlSpeak("zh-CN").Voice("zh-CN-YunxiNeural", SsmlText("你好"), SsmlBreak(time.Second))
+	resp, funcClose, err := tts.TextToVoice(Audio16kHz32KbitrateMonoMp3, doc)
+	defer funcClose()
+	if err != nil {
+		t.Fatalf("TextToVoice报错 err:%v", err)
+	}
+
+	data, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(data), doc.String()) {
+		t.Errorf("服务收到的SSML %s", data)
+	}
+}
@@ -0,0 +1,458 @@
+package go_micro_tts
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// SsmlMaxBytes 单次短文本合成请求 SSML 的最大字节数
+	SsmlMaxBytes = 64 * 1024
+	// SsmlMaxVoices 单次短文本合成请求 <voice> 元素的最大数量
+	SsmlMaxVoices = 50
+	// ssmlMaxBreak <break> 停顿的最大时长
+	ssmlMaxBreak = 20 * time.Second
+)
+
+// VoiceFinder 按短名称（如 zh-CN-YunxiNeural）查找语音，用于 SSML 校验
+type VoiceFinder interface {
+	FindVoice(shortName string) (*VoiceList, bool)
+}
+
+// voiceSuggester 可提示相近语音名称的 VoiceFinder，如 *VoiceCatalog
+type voiceSuggester interface {
+	Suggest(name string, n int) []VoiceList
+}
+
+// VoiceLists 实现 VoiceFinder 的语音列表，可由 GetVoiceList 的结果转换：VoiceLists(*list)
+type VoiceLists []VoiceList
+
+// FindVoice 实现 VoiceFinder，名称不区分大小写
+func (l VoiceLists) FindVoice(shortName string) (*VoiceList, bool) {
+	for i := range l {
+		if strings.EqualFold(l[i].ShortName, shortName) {
+			return &l[i], true
+		}
+	}
+	return nil, false
+}
+
+// SsmlValidateOptions SSML 校验选项
+type SsmlValidateOptions struct {
+	Voices   VoiceFinder // 非 nil 时检查语音是否存在，以及语音与 <speak> 的 xml:lang 是否一致
+	MaxBytes int         // SSML 最大字节数，为 0 时使用 SsmlMaxBytes，小于 0 时不限制
+	MaxChars int         // 朗读文本（不含标签）的最大字符数，为 0 时不限制
+}
+
+// WithSsmlValidation TextToVoice 发送请求前先在本地校验 SSML，校验失败返回 *SsmlValidationError
+func WithSsmlValidation(opts *SsmlValidateOptions) Option {
+	return func(g *GoTTS) {
+		if opts == nil {
+			opts = &SsmlValidateOptions{}
+		}
+		g.ssmlValidate = opts
+	}
+}
+
+// SsmlError SSML 中的一处错误
+type SsmlError struct {
+	Line    int    // 行号，从 1 开始
+	Column  int    // 列号（按字节），从 1 开始
+	Element string // 出错的元素
+	Message string
+}
+
+func (e *SsmlError) Error() string {
+	if e.Element != "" {
+		return fmt.Sprintf("%d:%d: <%s>: %s", e.Line, e.Column, e.Element, e.Message)
+	}
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Message)
+}
+
+// SsmlValidationError SSML 校验失败，包含全部错误，可通过 errors.Is(err, ErrInvalidSSML) 判断
+type SsmlValidationError struct {
+	Errors []*SsmlError
+}
+
+func (e *SsmlValidationError) Error() string {
+	msg := make([]string, 0, len(e.Errors))
+	for _, v := range e.Errors {
+		msg = append(msg, v.Error())
+	}
+	return "invalid ssml: " + strings.Join(msg, "; ")
+}
+
+func (e *SsmlValidationError) Unwrap() error {
+	return ErrInvalidSSML
+}
+
+// ssmlElements 允许的元素及其属性
+// https://learn.microsoft.com/zh-cn/azure/ai-services/speech-service/speech-synthesis-markup
+var ssmlElements = map[string][]string{
+	"speak":                 {"version", "xml:lang", "xml:base", "xmlns", "xmlns:mstts", "xmlns:emo"},
+	"voice":                 {"name", "effect", "xml:lang", "xml:gender"},
+	"prosody":               {"rate", "pitch", "volume", "contour", "range"},
+	"break":                 {"time", "strength"},
+	"emphasis":              {"level"},
+	"say-as":                {"interpret-as", "format", "detail"},
+	"phoneme":               {"alphabet", "ph"},
+	"sub":                   {"alias"},
+	"lang":                  {"xml:lang"},
+	"audio":                 {"src"},
+	"bookmark":              {"mark"},
+	"lexicon":               {"uri"},
+	"p":                     {},
+	"s":                     {},
+	"mstts:express-as":      {"style", "styledegree", "role"},
+	"mstts:silence":         {"type", "value"},
+	"mstts:backgroundaudio": {"src", "volume", "fadein", "fadeout"},
+	"mstts:viseme":          {"type"},
+	"mstts:audioduration":   {"value"},
+	"mstts:ttsembedding":    {"speakerProfileId"},
+}
+
+// ssmlEnums 取值为枚举的属性
+var ssmlEnums = map[string][]string{
+	"break@strength": {"x-weak", "weak", "medium", "strong", "x-strong"},
+	"emphasis@level": {"reduced", "none", "moderate", "strong"},
+	"mstts:silence@type": {"Leading", "Leading-exact", "Tailing", "Tailing-exact", "Sentenceboundary",
+		"Sentenceboundary-exact", "Comma-exact", "Semicolon-exact", "Enumerationcomma-exact"},
+	"phoneme@alphabet":  {"ipa", "sapi", "ups", "x-microsoft-sapi"},
+	"mstts:viseme@type": {"redlips_front", "FacialExpression"},
+}
+
+var (
+	ssmlRateWords   = []string{"x-slow", "slow", "medium", "fast", "x-fast", "default"}
+	ssmlPitchWords  = []string{"x-low", "low", "medium", "high", "x-high", "default"}
+	ssmlVolumeWords = []string{"silent", "x-soft", "soft", "medium", "loud", "x-loud", "default"}
+
+	ssmlPercentRegexp = regexp.MustCompile(`^([+-]?\d+(?:\.\d+)?)%$`)
+	ssmlPitchRegexp   = regexp.MustCompile(`^[+-]?\d+(?:\.\d+)?(?:Hz|st)$`)
+	ssmlTimeRegexp    = regexp.MustCompile(`^(\d+(?:\.\d+)?)(ms|s)$`)
+)
+
+// ssmlValidator 单次校验的状态
+type ssmlValidator struct {
+	opts   *SsmlValidateOptions
+	src    []byte
+	errs   []*SsmlError
+	stack  []string
+	lang   string
+	voices int
+	chars  int
+}
+
+// ValidateSSML 在本地校验 SSML：格式是否正确、元素及属性是否受支持、语音是否存在、
+// 语音与 <speak> 的区域设置是否一致、韵律取值范围以及长度限制
+// 校验失败返回 *SsmlValidationError，其中包含每处错误的行列位置
+func ValidateSSML(ssml []byte, opts *SsmlValidateOptions) error {
+	if opts == nil {
+		opts = &SsmlValidateOptions{}
+	}
+
+	v := &ssmlValidator{opts: opts, src: ssml}
+	v.validate()
+
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return &SsmlValidationError{Errors: v.errs}
+}
+
+func (v *ssmlValidator) validate() {
+	maxBytes := v.opts.MaxBytes
+	if maxBytes == 0 {
+		maxBytes = SsmlMaxBytes
+	}
+	if maxBytes > 0 && len(v.src) > maxBytes {
+		v.addAt(1, 1, "", fmt.Sprintf("ssml is %d bytes, exceeds the limit of %d bytes", len(v.src), maxBytes))
+	}
+
+	d := xml.NewDecoder(bytes.NewReader(v.src))
+	root := false
+	for {
+		line, column := d.InputPos()
+		tok, err := d.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			line, column = d.InputPos()
+			msg := err.Error()
+			var syntaxErr *xml.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				msg = syntaxErr.Msg
+			}
+			v.addAt(line, column, "", msg)
+			return
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			name := xmlName(t.Name)
+			if len(v.stack) == 0 {
+				if root || name != "speak" {
+					v.addAt(line, column, name, "the root element must be a single <speak>")
+				}
+				root = true
+			}
+			v.startElement(line, column, name, t.Attr)
+			v.stack = append(v.stack, name)
+		case xml.EndElement:
+			name := xmlName(t.Name)
+			if len(v.stack) == 0 || v.stack[len(v.stack)-1] != name {
+				v.addAt(line, column, name, "unexpected end element")
+				return
+			}
+			v.stack = v.stack[:len(v.stack)-1]
+		case xml.CharData:
+			text := strings.TrimSpace(string(t))
+			if text == "" {
+				continue
+			}
+			if !v.inside("voice") {
+				v.addAt(line, column, v.parent(), "text must be inside a <voice> element")
+			}
+			v.chars += utf8.RuneCountInString(text)
+		}
+	}
+
+	if !root {
+		v.addAt(1, 1, "", "the root element must be a single <speak>")
+	}
+	if len(v.stack) > 0 {
+		line, column := d.InputPos()
+		v.addAt(line, column, v.stack[len(v.stack)-1], "element is not closed")
+	}
+	if v.voices > SsmlMaxVoices {
+		v.addAt(1, 1, "speak", fmt.Sprintf("%d voice elements exceed the limit of %d", v.voices, SsmlMaxVoices))
+	}
+	if v.opts.MaxChars > 0 && v.chars > v.opts.MaxChars {
+		v.addAt(1, 1, "speak", fmt.Sprintf("text is %d characters, exceeds the limit of %d", v.chars, v.opts.MaxChars))
+	}
+}
+
+func (v *ssmlValidator) startElement(line, column int, name string, attrs []xml.Attr) {
+	allowed, ok := ssmlElements[name]
+	if !ok {
+		v.addAt(line, column, name, "unsupported element")
+		return
+	}
+
+	values := map[string]string{}
+	for _, a := range attrs {
+		attr := xmlName(a.Name)
+		if !contains(allowed, attr) {
+			v.addAt(line, column, name, fmt.Sprintf("unsupported attribute %q", attr))
+			continue
+		}
+		values[attr] = a.Value
+		if enum, ok := ssmlEnums[name+"@"+attr]; ok && !contains(enum, a.Value) {
+			v.addAt(line, column, name, fmt.Sprintf("invalid %s %q, expected one of %s", attr, a.Value, strings.Join(enum, ", ")))
+		}
+	}
+
+	parent := v.parent()
+	switch name {
+	case "speak":
+		if parent != "" {
+			v.addAt(line, column, name, "<speak> must be the root element")
+		}
+		if values["version"] == "" {
+			v.addAt(line, column, name, "the version attribute is required")
+		}
+		v.lang = values["xml:lang"]
+		if v.lang == "" {
+			v.addAt(line, column, name, "the xml:lang attribute is required")
+		}
+	case "voice":
+		v.voices++
+		if parent != "speak" {
+			v.addAt(line, column, name, "<voice> must be a direct child of <speak>")
+		}
+		v.checkVoice(line, column, values["name"])
+	case "mstts:backgroundaudio", "lexicon":
+		if parent != "speak" {
+			v.addAt(line, column, name, fmt.Sprintf("<%s> must be a direct child of <speak>", name))
+		}
+	default:
+		if !v.inside("voice") {
+			v.addAt(line, column, name, "element must be inside a <voice> element")
+		}
+	}
+
+	switch name {
+	case "prosody":
+		v.checkProsody(line, column, values)
+	case "break":
+		if t, ok := values["time"]; ok {
+			v.checkTime(line, column, name, "time", t, ssmlMaxBreak)
+		}
+	case "mstts:silence":
+		v.checkTime(line, column, name, "value", values["value"], 0)
+	case "mstts:express-as":
+		if s, ok := values["styledegree"]; ok {
+			f, err := strconv.ParseFloat(s, 64)
+			if err != nil || f < 0.01 || f > 2 {
+				v.addAt(line, column, name, fmt.Sprintf("styledegree %q must be between 0.01 and 2", s))
+			}
+		}
+	case "say-as":
+		if values["interpret-as"] == "" {
+			v.addAt(line, column, name, "the interpret-as attribute is required")
+		}
+	case "phoneme":
+		if values["ph"] == "" {
+			v.addAt(line, column, name, "the ph attribute is required")
+		}
+	case "sub":
+		if values["alias"] == "" {
+			v.addAt(line, column, name, "the alias attribute is required")
+		}
+	case "audio", "mstts:backgroundaudio":
+		if values["src"] == "" {
+			v.addAt(line, column, name, "the src attribute is required")
+		}
+	case "lang":
+		if values["xml:lang"] == "" {
+			v.addAt(line, column, name, "the xml:lang attribute is required")
+		}
+	}
+}
+
+// checkVoice 检查语音是否存在，以及与 <speak> 的区域设置是否一致
+func (v *ssmlValidator) checkVoice(line, column int, name string) {
+	if name == "" {
+		v.addAt(line, column, "voice", "the name attribute is required")
+		return
+	}
+	if v.opts.Voices == nil {
+		return
+	}
+
+	voice, ok := v.opts.Voices.FindVoice(name)
+	if !ok {
+		msg := fmt.Sprintf("unknown voice %q", name)
+		if s, ok := v.opts.Voices.(voiceSuggester); ok {
+			if similar := s.Suggest(name, 1); len(similar) > 0 {
+				msg += fmt.Sprintf(", did you mean %q?", similar[0].ShortName)
+			}
+		}
+		v.addAt(line, column, "voice", msg)
+		return
+	}
+	if v.lang != "" && !voice.SupportsLocale(v.lang) {
+		v.addAt(line, column, "voice", fmt.Sprintf("voice %q (%s) does not match speak xml:lang %q", name, voice.Locale, v.lang))
+	}
+}
+
+func (v *ssmlValidator) checkProsody(line, column int, values map[string]string) {
+	if rate, ok := values["rate"]; ok && !contains(ssmlRateWords, rate) {
+		if !inPercentRange(rate, -50, 100) && !inNumberRange(rate, 0.5, 2) {
+			v.addAt(line, column, "prosody", fmt.Sprintf("rate %q must be between -50%% and +100%%, 0.5 and 2, or one of %s", rate, strings.Join(ssmlRateWords, ", ")))
+		}
+	}
+	if pitch, ok := values["pitch"]; ok && !contains(ssmlPitchWords, pitch) {
+		if !inPercentRange(pitch, -50, 50) && !ssmlPitchRegexp.MatchString(pitch) {
+			v.addAt(line, column, "prosody", fmt.Sprintf("pitch %q must be like +10Hz, -2st, -50%%~+50%% or one of %s", pitch, strings.Join(ssmlPitchWords, ", ")))
+		}
+	}
+	if volume, ok := values["volume"]; ok && !contains(ssmlVolumeWords, volume) {
+		if !inPercentRange(volume, -100, 100) && !inNumberRange(volume, 0, 100) && !inSignedRange(volume, -100, 100) {
+			v.addAt(line, column, "prosody", fmt.Sprintf("volume %q must be between 0 and 100, -100%% and +100%%, or one of %s", volume, strings.Join(ssmlVolumeWords, ", ")))
+		}
+	}
+}
+
+// checkTime 检查时长格式，max 大于 0 时检查最大值
+func (v *ssmlValidator) checkTime(line, column int, element, attr, value string, max time.Duration) {
+	d, ok := parseSsmlTime(value)
+	if !ok {
+		v.addAt(line, column, element, fmt.Sprintf("%s %q must be like 500ms or 2s", attr, value))
+		return
+	}
+	if max > 0 && d > max {
+		v.addAt(line, column, element, fmt.Sprintf("%s %q exceeds the maximum of %s", attr, value, max))
+	}
+}
+
+func (v *ssmlValidator) addAt(line, column int, element, message string) {
+	v.errs = append(v.errs, &SsmlError{Line: line, Column: column, Element: element, Message: message})
+}
+
+func (v *ssmlValidator) parent() string {
+	if len(v.stack) == 0 {
+		return ""
+	}
+	return v.stack[len(v.stack)-1]
+}
+
+func (v *ssmlValidator) inside(name string) bool {
+	return contains(v.stack, name)
+}
+
+// parseSsmlTime 解析 SSML 的时间格式，如 500ms、2s
+func parseSsmlTime(value string) (time.Duration, bool) {
+	m := ssmlTimeRegexp.FindStringSubmatch(value)
+	if m == nil {
+		return 0, false
+	}
+	f, _ := strconv.ParseFloat(m[1], 64)
+	if m[2] == "s" {
+		return time.Duration(f * float64(time.Second)), true
+	}
+	return time.Duration(f * float64(time.Millisecond)), true
+}
+
+// parseSsmlPercent 解析百分比，如 +20%
+func parseSsmlPercent(value string) (float64, bool) {
+	m := ssmlPercentRegexp.FindStringSubmatch(value)
+	if m == nil {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(m[1], 64)
+	return f, err == nil
+}
+
+func inPercentRange(value string, min, max float64) bool {
+	f, ok := parseSsmlPercent(value)
+	return ok && f >= min && f <= max
+}
+
+func inNumberRange(value string, min, max float64) bool {
+	if strings.HasPrefix(value, "+") || strings.HasPrefix(value, "-") {
+		return false
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	return err == nil && f >= min && f <= max
+}
+
+func inSignedRange(value string, min, max float64) bool {
+	f, err := strconv.ParseFloat(value, 64)
+	return err == nil && f >= min && f <= max
+}
+
+// xmlName 还原带前缀的名称，如 xml:lang、mstts:express-as
+func xmlName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
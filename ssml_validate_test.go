@@ -0,0 +1,97 @@
+package go_micro_tts
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+var testVoices = VoiceLists{
+	{ShortName: "zh-CN-YunxiNeural", Locale: "zh-CN", Gender: "Male"},
+	{ShortName: "en-US-JennyNeural", Locale: "en-US", Gender: "Female"},
+	{ShortName: "en-US-JennyMultilingualNeural", Locale: "en-US", Gender: "Female"},
+}
+
+func TestValidateSSML(t *testing.T) {
+	ssml := NewSpeakXml(&SpeakXmlReq{Lang: "zh-CN", Gender: "Male", Name: "zh-CN-YunxiNeural", Text: "你好"})
+	data, _ := ssml.Ssml()
+	if err := ValidateSSML(data, &SsmlValidateOptions{Voices: testVoices}); err != nil {
+		t.Errorf("NewSpeakXml 生成的 SSML 校验失败 err:%v", err)
+	}
+
+	doc := NewSsmlSpeak("zh-CN").
+		Voice("zh-CN-YunxiNeural", SsmlProsody(SsmlProsodyAttr{Rate: "+20%", Pitch: "+10Hz", Volume: "loud"}, SsmlText("你好"))).
+		Voice("en-US-JennyMultilingualNeural", SsmlLang("zh-CN", SsmlText("你好")))
+	data, _ = doc.Ssml()
+	if err := ValidateSSML(data, &SsmlValidateOptions{Voices: testVoices}); err != nil {
+		t.Errorf("SsmlSpeak 生成的 SSML 校验失败 err:%v", err)
+	}
+}
+
+func TestValidateSSMLErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		ssml string
+		want string
+	}{
+		{"格式错误", `<speak version="1.0" xml:lang="zh-CN"><voice name="zh-CN-YunxiNeural">你好</speak>`, "1:"},
+		{"根元素", `<voice name="zh-CN-YunxiNeural">你好</voice>`, "the root element must be a single <speak>"},
+		{"未知元素", `<speak version="1.0" xml:lang="zh-CN"><voice name="zh-CN-YunxiNeural"><foo>你好</foo></voice></speak>`, "<foo>: unsupported element"},
+		{"未知属性", `<speak version="1.0" xml:lang="zh-CN"><voice name="zh-CN-YunxiNeural" speed="1">你好</voice></speak>`, `unsupported attribute "speed"`},
+		{"未知语音", `<speak version="1.0" xml:lang="zh-CN"><voice name="zh-CN-YunxiNeurall">你好</voice></speak>`, `unknown voice "zh-CN-YunxiNeurall"`},
+		{"区域不一致", `<speak version="1.0" xml:lang="zh-CN"><voice name="en-US-JennyNeural">hi</voice></speak>`, "does not match speak xml:lang"},
+		{"语速", `<speak version="1.0" xml:lang="zh-CN"><voice name="zh-CN-YunxiNeural"><prosody rate="+300%">你好</prosody></voice></speak>`, `rate "+300%"`},
+		{"停顿", `<speak version="1.0" xml:lang="zh-CN"><voice name="zh-CN-YunxiNeural"><break time="30s"/></voice></speak>`, "exceeds the maximum"},
+		{"文本位置", `<speak version="1.0" xml:lang="zh-CN">你好<voice name="zh-CN-YunxiNeural">你好</voice></speak>`, "text must be inside a <voice> element"},
+		{"风格强度", `<speak version="1.0" xml:lang="zh-CN"><voice name="zh-CN-YunxiNeural"><mstts:express-as style="sad" styledegree="3">你好</mstts:express-as></voice></speak>`, "styledegree"},
+	}
+
+	for _, c := range cases {
+		err := ValidateSSML([]byte(c.ssml), &SsmlValidateOptions{Voices: testVoices})
+		if err == nil || !strings.Contains(err.Error(), c.want) {
+			t.Errorf("%s: err = %v, 期望包含 %q", c.name, err, c.want)
+		}
+		if !errors.Is(err, ErrInvalidSSML) {
+			t.Errorf("%s: err = %v, 期望 ErrInvalidSSML", c.name, err)
+		}
+	}
+}
+
+func TestValidateSSMLPosition(t *testing.T) {
+	ssml := "<speak version=\"1.0\" xml:lang=\"zh-CN\">\n  <voice name=\"zh-CN-YunxiNeural\">\n    <prosody rate=\"fastest\">你好</prosody>\n  </voice>\n</speak>"
+
+	err := ValidateSSML([]byte(ssml), nil)
+	var validationErr *SsmlValidationError
+	if !errors.As(err, &validationErr) || len(validationErr.Errors) != 1 {
+		t.Fatalf("err = %v", err)
+	}
+	if e := validationErr.Errors[0]; e.Line != 3 || e.Column != 5 || e.Element != "prosody" {
+		t.Errorf("错误位置 = %d:%d <%s>, 期望 3:5 <prosody>", e.Line, e.Column, e.Element)
+	}
+}
+
+func TestValidateSSMLLimits(t *testing.T) {
+	ssml := `<speak version="1.0" xml:lang="zh-CN"><voice name="zh-CN-YunxiNeural">你好世界</voice></speak>`
+
+	if err := ValidateSSML([]byte(ssml), &SsmlValidateOptions{MaxChars: 3}); err == nil {
+		t.Error("超出字符数限制时应报错")
+	}
+	if err := ValidateSSML([]byte(ssml), &SsmlValidateOptions{MaxBytes: 10}); err == nil {
+		t.Error("超出字节数限制时应报错")
+	}
+}
+
+func TestTextToVoiceSsmlValidation(t *testing.T) {
+	tts, srv := newTestTTS(t)
+	tts.ssmlValidate = &SsmlValidateOptions{Voices: testVoices}
+
+	doc := NewSsmlSpeak("zh-CN").Voice("zh-CN-YunxiNeurall", SsmlText("你好"))
+	_, funcClose, err := tts.TextToVoice(Audio16kHz32KbitrateMonoMp3, doc)
+	funcClose()
+	if !errors.Is(err, ErrInvalidSSML) {
+		t.Fatalf("err = %v, 期望 ErrInvalidSSML", err)
+	}
+	if n := srv.Requests(""); n != 0 {
+		t.Errorf("校验失败时不应发送请求, 请求次数 = %d", n)
+	}
+}
@@ -0,0 +1,330 @@
+package go_micro_tts
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SubtitleFormat 字幕格式
+type SubtitleFormat string
+
+const (
+	SubtitleSRT    SubtitleFormat = "srt"
+	SubtitleWebVTT SubtitleFormat = "vtt"
+	SubtitleLRC    SubtitleFormat = "lrc"
+)
+
+const (
+	defaultSubtitleLineLength  = 42
+	defaultSubtitleLines       = 2
+	defaultSubtitleCueDuration = 7 * time.Second
+)
+
+// SubtitleOptions 生成字幕的参数
+type SubtitleOptions struct {
+	MaxLineLength  int           // 每行最大宽度，全角字符按 2 计算，默认 42
+	MaxLines       int           // 每条字幕最大行数，默认 2
+	MaxCueDuration time.Duration // 每条字幕最长显示时间，默认 7 秒
+}
+
+// Cue 一条字幕
+type Cue struct {
+	Start time.Duration
+	End   time.Duration
+	Lines []string
+}
+
+// Text 字幕文本，多行以换行符连接
+func (c Cue) Text() string {
+	return strings.Join(c.Lines, "\n")
+}
+
+// BuildCues 由字边界或句子边界生成字幕
+//
+// 只有字边界时，按句末标点、行宽及时长将字合并为字幕；
+// 同时有字边界及句子边界时，每个句子单独成为字幕，过长的句子按字边界拆分；
+// 只有句子边界时，过长的句子按文本长度比例拆分时间
+func BuildCues(boundaries []Boundary, opts *SubtitleOptions) []Cue {
+	o := subtitleOptions(opts)
+
+	var words, sentences []Boundary
+	for _, b := range boundaries {
+		if strings.TrimSpace(b.Text) == "" {
+			continue
+		}
+		if b.Kind == BoundarySentence {
+			sentences = append(sentences, b)
+		} else {
+			words = append(words, b)
+		}
+	}
+
+	if len(words) == 0 {
+		var cues []Cue
+		for _, s := range sentences {
+			cues = append(cues, o.splitSentence(s)...)
+		}
+		return cues
+	}
+	return o.mergeWords(words, sentences)
+}
+
+func subtitleOptions(opts *SubtitleOptions) SubtitleOptions {
+	o := SubtitleOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.MaxLineLength <= 0 {
+		o.MaxLineLength = defaultSubtitleLineLength
+	}
+	if o.MaxLines <= 0 {
+		o.MaxLines = defaultSubtitleLines
+	}
+	if o.MaxCueDuration <= 0 {
+		o.MaxCueDuration = defaultSubtitleCueDuration
+	}
+	return o
+}
+
+// mergeWords 将字边界合并为字幕
+func (o SubtitleOptions) mergeWords(words, sentences []Boundary) []Cue {
+	var cues []Cue
+	var cur []Boundary
+
+	flush := func() {
+		if len(cur) == 0 {
+			return
+		}
+		text := joinWords(cur)
+		// 字幕恰好为一个完整句子时使用句子的文本，保留字边界中没有的标点
+		if i := BoundaryAt(sentences, cur[0].Offset); i >= 0 && sentences[i].Offset == cur[0].Offset &&
+			len(cur) == len(wordsIn(words, sentences[i])) && len(o.wrap(sentences[i].Text)) <= o.MaxLines {
+			text = sentences[i].Text
+		}
+		cues = append(cues, Cue{Start: cur[0].Offset, End: cur[len(cur)-1].End(), Lines: o.wrap(text)})
+		cur = nil
+	}
+
+	for _, w := range words {
+		if len(cur) > 0 {
+			last := cur[len(cur)-1]
+			switch {
+			case len(sentences) > 0 && BoundaryAt(sentences, w.Offset) != BoundaryAt(sentences, last.Offset):
+				flush()
+			case len(sentences) == 0 && endsSentence(last.Text):
+				flush()
+			case w.End()-cur[0].Offset > o.MaxCueDuration:
+				flush()
+			case len(o.wrap(joinWords(append(cur[:len(cur):len(cur)], w)))) > o.MaxLines:
+				flush()
+			}
+		}
+		cur = append(cur, w)
+	}
+	flush()
+
+	return cues
+}
+
+// wordsIn 返回位于句子时间范围内的字
+func wordsIn(words []Boundary, sentence Boundary) []Boundary {
+	var res []Boundary
+	for _, w := range words {
+		if w.Offset >= sentence.Offset && w.Offset < sentence.End() {
+			res = append(res, w)
+		}
+	}
+	return res
+}
+
+// splitSentence 句子过长时按文本长度比例拆分
+func (o SubtitleOptions) splitSentence(s Boundary) []Cue {
+	lines := o.wrap(s.Text)
+	chunks := (len(lines) + o.MaxLines - 1) / o.MaxLines
+	if n := int((s.Duration + o.MaxCueDuration - 1) / o.MaxCueDuration); n > chunks && n <= len(lines) {
+		chunks = n
+	}
+
+	perChunk := (len(lines) + chunks - 1) / chunks
+	total := 0
+	for _, l := range lines {
+		total += textWidth(l)
+	}
+
+	var cues []Cue
+	start, width := s.Offset, 0
+	for i := 0; i < len(lines); i += perChunk {
+		part := lines[i:min(i+perChunk, len(lines))]
+		for _, l := range part {
+			width += textWidth(l)
+		}
+		end := s.End()
+		if i+perChunk < len(lines) && total > 0 {
+			end = s.Offset + time.Duration(int64(s.Duration)*int64(width)/int64(total))
+		}
+		cues = append(cues, Cue{Start: start, End: end, Lines: part})
+		start = end
+	}
+	return cues
+}
+
+// wrap 按行宽折行，拉丁文字在空格处折行，中日韩文字可在任意字符间折行，行首不出现句读标点
+func (o SubtitleOptions) wrap(text string) []string {
+	var lines []string
+	line, width := "", 0
+
+	for _, token := range tokenize(text) {
+		sep := ""
+		if line != "" && needSpace(line, token) {
+			sep = " "
+		}
+		w := textWidth(sep + token)
+		if line != "" && width+w > o.MaxLineLength && !isClosingPunct(token) {
+			lines = append(lines, line)
+			line, width, sep = "", 0, ""
+			w = textWidth(token)
+		}
+		line += sep + token
+		width += w
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// tokenize 拆分为不可再分的片段：中日韩文字及标点为单个字符，其他按空格分词
+func tokenize(text string) []string {
+	var tokens []string
+	word := strings.Builder{}
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, word.String())
+			word.Reset()
+		}
+	}
+
+	for _, r := range text {
+		switch {
+		case unicode.IsSpace(r):
+			flush()
+		case isCJK(r):
+			flush()
+			tokens = append(tokens, string(r))
+		default:
+			word.WriteRune(r)
+		}
+	}
+	flush()
+	return tokens
+}
+
+// joinWords 连接字边界的文本，中日韩文字之间及标点前不加空格
+func joinWords(words []Boundary) string {
+	text := ""
+	for _, w := range words {
+		t := strings.TrimSpace(w.Text)
+		if text != "" && needSpace(text, t) {
+			text += " "
+		}
+		text += t
+	}
+	return text
+}
+
+func needSpace(left, right string) bool {
+	l, _ := utf8.DecodeLastRuneInString(left)
+	r, _ := utf8.DecodeRuneInString(right)
+	if isCJK(l) || isCJK(r) {
+		return false
+	}
+	return !unicode.IsPunct(r) || strings.ContainsRune("([{\"'¿¡", r)
+}
+
+// endsSentence 文本是否以句末标点结尾
+func endsSentence(text string) bool {
+	r, _ := utf8.DecodeLastRuneInString(strings.TrimSpace(text))
+	return strings.ContainsRune(".!?。！？…", r)
+}
+
+func isClosingPunct(token string) bool {
+	r, _ := utf8.DecodeRuneInString(token)
+	return strings.ContainsRune(",.!?;:)]}，。！？、；：」』）》〉…", r)
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) ||
+		(r >= 0x3000 && r <= 0x303f) || (r >= 0xff00 && r <= 0xffef)
+}
+
+// textWidth 显示宽度，全角字符按 2 计算
+func textWidth(text string) int {
+	width := 0
+	for _, r := range text {
+		if isCJK(r) {
+			width += 2
+		} else {
+			width++
+		}
+	}
+	return width
+}
+
+// WriteSubtitle 将字幕以指定格式写入 w
+func WriteSubtitle(w io.Writer, format SubtitleFormat, cues []Cue) error {
+	bw := bufio.NewWriter(w)
+
+	switch format {
+	case SubtitleSRT:
+		for i, c := range cues {
+			fmt.Fprintf(bw, "%d\n%s --> %s\n%s\n\n", i+1, subtitleTime(c.Start, ","), subtitleTime(c.End, ","), c.Text())
+		}
+	case SubtitleWebVTT:
+		bw.WriteString("WEBVTT\n\n")
+		for _, c := range cues {
+			fmt.Fprintf(bw, "%s --> %s\n%s\n\n", subtitleTime(c.Start, "."), subtitleTime(c.End, "."), vttEscaper.Replace(c.Text()))
+		}
+	case SubtitleLRC:
+		for _, c := range cues {
+			fmt.Fprintf(bw, "[%s]%s\n", lrcTime(c.Start), strings.Join(c.Lines, " "))
+		}
+		// 最后一条字幕结束时清空歌词
+		if len(cues) > 0 {
+			fmt.Fprintf(bw, "[%s]\n", lrcTime(cues[len(cues)-1].End))
+		}
+	default:
+		return fmt.Errorf("unsupported subtitle format %q", format)
+	}
+
+	return bw.Flush()
+}
+
+// Subtitle 由边界直接生成指定格式的字幕
+func Subtitle(format SubtitleFormat, boundaries []Boundary, opts *SubtitleOptions) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := WriteSubtitle(buf, format, BuildCues(boundaries, opts)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// vttEscaper WebVTT 字幕文本中的 &、< 须转义，转义 > 以免出现 -->
+var vttEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// subtitleTime SRT、WebVTT 的时间格式 hh:mm:ss,mmm
+func subtitleTime(d time.Duration, sep string) string {
+	ms := d.Milliseconds()
+	return fmt.Sprintf("%02d:%02d:%02d%s%03d", ms/3600000, ms/60000%60, ms/1000%60, sep, ms%1000)
+}
+
+// lrcTime LRC 的时间格式 mm:ss.xx
+func lrcTime(d time.Duration) string {
+	cs := d.Milliseconds() / 10
+	return fmt.Sprintf("%02d:%02d.%02d", cs/6000, cs/100%60, cs%100)
+}
@@ -0,0 +1,121 @@
+package go_micro_tts
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// testWords 每个字 250ms、间隔 50ms，与 ttstest 生成的字边界一致
+func testWords(texts ...string) []Boundary {
+	var res []Boundary
+	offset := time.Duration(0)
+	for _, text := range texts {
+		res = append(res, Boundary{Kind: BoundaryWord, Text: text, Offset: offset, Duration: 250 * time.Millisecond})
+		offset += 300 * time.Millisecond
+	}
+	return res
+}
+
+func TestBuildCuesWords(t *testing.T) {
+	words := testWords("Hello", "world.", "How", "are", "you?")
+	cues := BuildCues(words, nil)
+	if len(cues) != 2 || cues[0].Text() != "Hello world." || cues[1].Text() != "How are you?" {
+		t.Fatalf("cues = %+v", cues)
+	}
+	if cues[1].Start != 600*time.Millisecond || cues[1].End != 1450*time.Millisecond {
+		t.Errorf("cues[1] = %+v", cues[1])
+	}
+
+	// 超过最长显示时间时拆分
+	cues = BuildCues(words, &SubtitleOptions{MaxCueDuration: 600 * time.Millisecond})
+	if len(cues) != 3 || cues[1].Text() != "How are" {
+		t.Errorf("cues = %+v", cues)
+	}
+
+	// 超过行宽及行数时拆分
+	cues = BuildCues(testWords("one", "two", "three", "four"), &SubtitleOptions{MaxLineLength: 8, MaxLines: 1})
+	if len(cues) != 3 || cues[0].Text() != "one two" || cues[1].Text() != "three" {
+		t.Errorf("cues = %+v", cues)
+	}
+}
+
+func TestBuildCuesSentences(t *testing.T) {
+	words := testWords("你", "好", "世", "界", "再", "见")
+	sentences := []Boundary{
+		{Kind: BoundarySentence, Text: "你好，世界。", Offset: 0, Duration: 1150 * time.Millisecond},
+		{Kind: BoundarySentence, Text: "再见！", Offset: 1200 * time.Millisecond, Duration: 550 * time.Millisecond},
+	}
+
+	// 字边界按句子合并，使用句子中带标点的文本
+	cues := BuildCues(append(words, sentences...), nil)
+	if len(cues) != 2 || cues[0].Text() != "你好，世界。" || cues[1].Text() != "再见！" || cues[1].Start != 1200*time.Millisecond {
+		t.Errorf("cues = %+v", cues)
+	}
+
+	// 只有句子边界时，过长的句子按比例拆分
+	long := Boundary{Kind: BoundarySentence, Text: "第一行文字，第二行文字，第三行文字。", Offset: time.Second, Duration: 3 * time.Second}
+	cues = BuildCues([]Boundary{long}, &SubtitleOptions{MaxLineLength: 12, MaxLines: 1})
+	if len(cues) != 3 || cues[0].Text() != "第一行文字，" || cues[2].End != 4*time.Second {
+		t.Fatalf("cues = %+v", cues)
+	}
+	if cues[0].End != cues[1].Start || cues[0].End != 2*time.Second {
+		t.Errorf("cues = %+v", cues)
+	}
+}
+
+func TestSubtitleWrap(t *testing.T) {
+	o := subtitleOptions(&SubtitleOptions{MaxLineLength: 10})
+	cases := []struct {
+		text string
+		want string
+	}{
+		{"Hello world, how are you", "Hello|world, how|are you"},
+		{"中华兴盛，幸有斌哥", "中华兴盛，|幸有斌哥"},
+		{"你好hello世界", "你好hello|世界"},
+	}
+	for _, c := range cases {
+		if got := strings.Join(o.wrap(c.text), "|"); got != c.want {
+			t.Errorf("wrap(%q) = %q, want %q", c.text, got, c.want)
+		}
+	}
+}
+
+func TestWriteSubtitle(t *testing.T) {
+	cues := []Cue{
+		{Start: 50 * time.Millisecond, End: 1200 * time.Millisecond, Lines: []string{"你好，", "世界。"}},
+		{Start: 61*time.Minute + 1500*time.Millisecond, End: 61*time.Minute + 2*time.Second, Lines: []string{"再见"}},
+	}
+	cases := []struct {
+		format SubtitleFormat
+		want   string
+	}{
+		{SubtitleSRT, "1\n00:00:00,050 --> 00:00:01,200\n你好，\n世界。\n\n2\n01:01:01,500 --> 01:01:02,000\n再见\n\n"},
+		{SubtitleWebVTT, "WEBVTT\n\n00:00:00.050 --> 00:00:01.200\n你好，\n世界。\n\n01:01:01.500 --> 01:01:02.000\n再见\n\n"},
+		{SubtitleLRC, "[00:00.05]你好， 世界。\n[61:01.50]再见\n[61:02.00]\n"},
+	}
+	for _, c := range cases {
+		buf := &strings.Builder{}
+		if err := WriteSubtitle(buf, c.format, cues); err != nil {
+			t.Fatalf("%s: err:%v", c.format, err)
+		}
+		if buf.String() != c.want {
+			t.Errorf("%s:\n%s\nwant:\n%s", c.format, buf.String(), c.want)
+		}
+	}
+
+	buf := &strings.Builder{}
+	escaped := []Cue{{End: time.Second, Lines: []string{"a < b & c --> d"}}}
+	if err := WriteSubtitle(buf, SubtitleWebVTT, escaped); err != nil || !strings.Contains(buf.String(), "\na &lt; b &amp; c --&gt; d\n") {
+		t.Errorf("WebVTT 转义 = %q, err:%v", buf.String(), err)
+	}
+
+	if err := WriteSubtitle(&strings.Builder{}, "ass", cues); err == nil {
+		t.Error("不支持的格式应返回错误")
+	}
+
+	data, err := Subtitle(SubtitleSRT, testWords("Hi."), nil)
+	if err != nil || string(data) != "1\n00:00:00,000 --> 00:00:00,250\nHi.\n\n" {
+		t.Errorf("Subtitle = %q, err:%v", data, err)
+	}
+}
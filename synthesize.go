@@ -0,0 +1,116 @@
+package go_micro_tts
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"github.com/xuemingjings/go-micro-tts/audio"
+	"github.com/xuemingjings/go-micro-tts/internal"
+	"io"
+	"sync"
+)
+
+// SynthesizeReq 流式文本转语音请求
+type SynthesizeReq struct {
+	OutputFormat SsmlOut      // 音频输出格式
+	Ssml         SsmlDocument // 朗读内容，如 NewSpeakXml、NewSsmlSpeak 的结果
+}
+
+// AudioInfo 合成音频的信息
+type AudioInfo struct {
+	OutputFormat  SsmlOut // 音频输出格式
+	SampleRate    int     // 采样率（Hz）
+	ContentType   string  // 响应的 Content-Type，未返回时按输出格式填写
+	ContentLength int64   // 音频字节数，分块传输时为 -1
+	RequestId     string  // 请求 ID，用于向微软排查问题
+}
+
+// ErrEmptyAudio 服务返回了空的音频
+var ErrEmptyAudio = errors.New("http response audio is empty")
+
+// errNoSynthesizeReq 未传入请求或朗读内容
+var errNoSynthesizeReq = errors.New("synthesize request with ssml is required")
+
+// Synthesize 流式文本转语音
+// 返回的 io.ReadCloser 边接收边读取音频数据，可直接写入 http.ResponseWriter 或播放器，使用完毕后必须调用 Close
+// WithSynthesisTimeout 只限制等待响应头的时间，读取音频数据的时间不受限制，ctx 取消时中断请求及音频数据的读取
+func (g *GoTTS) Synthesize(ctx context.Context, req *SynthesizeReq) (io.ReadCloser, *AudioInfo, error) {
+	if req == nil || req.Ssml == nil {
+		return nil, nil, errNoSynthesizeReq
+	}
+	resp, funcClose, err := g.TextToVoiceContext(ctx, req.OutputFormat, req.Ssml)
+	if err != nil {
+		funcClose()
+		return nil, nil, err
+	}
+
+	// 分块传输时无法通过 ContentLength 判断是否为空，需预读第一个字节
+	body := bufio.NewReader(resp.Body)
+	if _, err := body.Peek(1); err != nil {
+		funcClose()
+		if err == io.EOF {
+			return nil, nil, ErrEmptyAudio
+		}
+		return nil, nil, err
+	}
+
+	format := req.OutputFormat.Info()
+	info := &AudioInfo{
+		OutputFormat:  req.OutputFormat,
+		SampleRate:    format.SampleRate,
+		ContentType:   resp.Header.Get("Content-Type"),
+		ContentLength: resp.ContentLength,
+		RequestId:     requestId(resp.Header),
+	}
+	if (info.ContentType == "" || info.ContentType == "application/octet-stream") && format.MimeType != "" {
+		info.ContentType = format.MimeType
+	}
+
+	return &audioStream{Reader: body, close: funcClose}, info, nil
+}
+
+// TextToVoiceTo 文本转语音并写入 w（如 bytes.Buffer、http.ResponseWriter、上传管道），返回写入的字节数
+func (g *GoTTS) TextToVoiceTo(ctx context.Context, outFormat SsmlOut, ssml SsmlDocument, w io.Writer) (int64, error) {
+	audio, _, err := g.Synthesize(ctx, &SynthesizeReq{OutputFormat: outFormat, Ssml: ssml})
+	if err != nil {
+		return 0, err
+	}
+	defer audio.Close()
+
+	return io.Copy(w, audio)
+}
+
+// TextToVoiceFile 文本转语音并写入文件 path，返回写入的字节数
+// 音频先写入同目录下的临时文件，全部成功后才重命名为 path，因此 path 不会出现不完整的音频
+// path 没有扩展名时按 outFormat 补全，实际写入 outFormat.FileName(path)，如 article 写入 article.mp3
+func (g *GoTTS) TextToVoiceFile(ctx context.Context, outFormat SsmlOut, ssml SsmlDocument, path string) (int64, error) {
+	return internal.WriteFileAtomic(outFormat.FileName(path), 0o644, func(w io.Writer) (int64, error) {
+		return g.TextToVoiceTo(ctx, outFormat, ssml, w)
+	})
+}
+
+// WavReader 为 Synthesize 返回的 raw 格式的 pcm、alaw、mulaw 音频加上 wav 文件头，边读取边转换，无需 ffmpeg 即可播放
+// 响应为分块传输时文件头中的长度为 0xFFFFFFFF；写入文件时可使用 audio.NewWavWriter 回填实际长度
+//
+//	rc, info, err := tts.Synthesize(ctx, &SynthesizeReq{OutputFormat: Raw8kHz8BitMonoMulaw, Ssml: doc})
+//	defer rc.Close()
+//	wav, err := WavReader(rc, info)
+func WavReader(r io.Reader, info *AudioInfo) (io.Reader, error) {
+	format, err := audio.ParseFormat(string(info.OutputFormat))
+	if err != nil {
+		return nil, err
+	}
+	return audio.NewWavReader(r, format, info.ContentLength)
+}
+
+// audioStream 合成音频的数据流，Close 可重复调用
+type audioStream struct {
+	io.Reader
+	once  sync.Once
+	close func()
+}
+
+func (s *audioStream) Close() error {
+	s.once.Do(s.close)
+	return nil
+}
@@ -0,0 +1,425 @@
+package go_micro_tts
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/xuemingjings/go-micro-tts/internal"
+	"github.com/xuemingjings/go-micro-tts/internal/websocket"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// SynthesisEventType 实时合成事件类型
+type SynthesisEventType string
+
+const (
+	EventAudio               SynthesisEventType = "Audio"               // 音频数据块
+	EventWordBoundary        SynthesisEventType = "WordBoundary"        // 字边界
+	EventSentenceBoundary    SynthesisEventType = "SentenceBoundary"    // 句子边界
+	EventPunctuationBoundary SynthesisEventType = "PunctuationBoundary" // 标点边界
+	EventViseme              SynthesisEventType = "Viseme"              // 口型
+	EventBookmark            SynthesisEventType = "Bookmark"            // SSML 中的 <bookmark>
+	EventSessionEnd          SynthesisEventType = "SessionEnd"          // 合成结束
+)
+
+// SynthesisEvent 实时合成事件
+type SynthesisEvent struct {
+	Type     SynthesisEventType
+	Offset   time.Duration // 事件在音频中的时间，EventAudio 为 0
+	Audio    []byte        // EventAudio 的音频数据
+	Boundary *Boundary     // EventWordBoundary、EventSentenceBoundary、EventPunctuationBoundary 的边界
+	Viseme   *Viseme       // EventViseme 的口型
+	Bookmark string        // EventBookmark 的书签名称
+}
+
+// Viseme 口型事件
+type Viseme struct {
+	Id        int           // 口型 ID
+	Offset    time.Duration // 在音频中的时间
+	Animation string        // mstts:viseme type="FacialExpression" 时的混合形状动画 JSON
+}
+
+// EventSynthesizeReq 实时合成请求，事件开关对应服务端的 metadataOptions
+type EventSynthesizeReq struct {
+	OutputFormat        SsmlOut      // 音频输出格式
+	Ssml                SsmlDocument // 朗读内容
+	WordBoundary        bool         // 返回字边界
+	SentenceBoundary    bool         // 返回句子边界
+	PunctuationBoundary bool         // 返回标点边界
+	Viseme              bool         // 返回口型
+	Bookmark            bool         // 返回书签
+}
+
+// WebSocketError 实时合成时服务端关闭了连接，如 SSML 无效时状态码为 1007
+type WebSocketError struct {
+	Code   int    // WebSocket 关闭状态码
+	Reason string // 服务端返回的原因
+}
+
+func (e *WebSocketError) Error() string {
+	return fmt.Sprintf("%s: websocket closed %d: %s", opSynthesizeEvents, e.Code, e.Reason)
+}
+
+// Unwrap 按关闭状态码返回对应的哨兵错误
+func (e *WebSocketError) Unwrap() error {
+	switch e.Code {
+	case websocket.CloseInvalidPayload:
+		return ErrInvalidSSML
+	case websocket.ClosePolicyViolation:
+		return ErrUnauthorized
+	case websocket.CloseInternalError, websocket.CloseGoingAway:
+		return ErrServer
+	}
+	return nil
+}
+
+// SynthesizeEvents 通过 WebSocket 实时合成，按顺序回调音频数据块及字边界、口型、书签等事件
+// fn 返回错误时中止合成并返回该错误；合成结束（EventSessionEnd 之后）返回 nil；ctx 取消时中断连接
+func (g *GoTTS) SynthesizeEvents(ctx context.Context, req *EventSynthesizeReq, fn func(ev *SynthesisEvent) error) error {
+	if req == nil || req.Ssml == nil {
+		return errNoSynthesizeReq
+	}
+	xmlData, err := req.Ssml.Ssml()
+	if err != nil {
+		return err
+	}
+	if g.ssmlValidate != nil {
+		if err := ValidateSSML(xmlData, g.ssmlValidate); err != nil {
+			return err
+		}
+	}
+
+	conn, err := g.dialWebSocket(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// ctx 取消时关闭连接，使阻塞的 ReadMessage 返回
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.CloseWithReason(websocket.CloseNormal, "")
+		case <-stop:
+		}
+	}()
+
+	reqId := newRequestId()
+	for _, m := range []struct {
+		path        string
+		contentType string
+		body        []byte
+	}{
+		{"speech.config", "application/json", speechConfig},
+		{"synthesis.context", "application/json", synthesisContext(req)},
+		{"ssml", "application/ssml+xml", xmlData},
+	} {
+		if err := conn.WriteMessage(websocket.TextMessage, wsTextMessage(m.path, reqId, m.contentType, m.body)); err != nil {
+			return wsError(ctx, err)
+		}
+	}
+
+	for {
+		typ, data, err := conn.ReadMessage()
+		if err != nil {
+			return wsError(ctx, err)
+		}
+
+		var events []*SynthesisEvent
+		switch typ {
+		case websocket.BinaryMessage:
+			path, body, err := parseWsBinaryMessage(data)
+			if err != nil {
+				return err
+			}
+			if path == "audio" && len(body) > 0 {
+				events = append(events, &SynthesisEvent{Type: EventAudio, Audio: body})
+			}
+		case websocket.TextMessage:
+			path, body := parseWsTextMessage(data)
+			switch path {
+			case "turn.end":
+				return nil
+			case "audio.metadata":
+				if events, err = parseAudioMetadata(body); err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, ev := range events {
+			if err := fn(ev); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// EventStream 实时合成的事件流
+type EventStream struct {
+	events chan *SynthesisEvent
+	cancel context.CancelFunc
+	done   chan struct{}
+	err    error
+}
+
+// StreamEvents 通过 WebSocket 实时合成，事件通过 Events 返回的 channel 按顺序发送
+//
+//	stream := tts.StreamEvents(ctx, req)
+//	defer stream.Close()
+//	for ev := range stream.Events() {
+//	}
+//	if err := stream.Err(); err != nil {
+//	}
+func (g *GoTTS) StreamEvents(ctx context.Context, req *EventSynthesizeReq) *EventStream {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &EventStream{
+		events: make(chan *SynthesisEvent, 16),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	// 请求无效时返回已结束的事件流，错误由 Err 返回
+	if req == nil || req.Ssml == nil {
+		cancel()
+		s.err = errNoSynthesizeReq
+		close(s.events)
+		close(s.done)
+		return s
+	}
+
+	go func() {
+		defer close(s.done)
+		defer close(s.events)
+		s.err = g.SynthesizeEvents(ctx, req, func(ev *SynthesisEvent) error {
+			select {
+			case s.events <- ev:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+
+	return s
+}
+
+// Events 事件 channel，合成结束或出错时关闭
+func (s *EventStream) Events() <-chan *SynthesisEvent {
+	return s.events
+}
+
+// Err Events 关闭后返回合成过程中的错误，正常结束时返回 nil
+func (s *EventStream) Err() error {
+	<-s.done
+	return s.err
+}
+
+// Close 中止合成并等待连接关闭，可重复调用
+func (s *EventStream) Close() error {
+	s.cancel()
+	<-s.done
+	return nil
+}
+
+// dialWebSocket 建立 WebSocket 连接，令牌失效时刷新后重试一次，并按重试策略重试限流及服务端错误
+func (g *GoTTS) dialWebSocket(ctx context.Context) (*websocket.Conn, error) {
+	token, err := g.getToken(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	refreshed := false
+	for attempt := 1; ; attempt++ {
+		uri := g.endpoint + apiWebSocket + "?X-ConnectionId=" + newRequestId()
+		header := http.Header{"Authorization": {"Bearer " + token}}
+
+		conn, resp, err := websocket.Dial(ctx, g.httpClient, uri, header)
+		if err == nil {
+			return conn, nil
+		}
+		if resp == nil {
+			if delay, ok := g.retryPolicy.retry(attempt, true, nil, err); ok {
+				if err := internal.Sleep(ctx, delay); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			return nil, err
+		}
+
+		if resp.StatusCode == http.StatusUnauthorized && !refreshed {
+			resp.Body.Close()
+			refreshed = true
+			if token, err = g.getToken(ctx, token); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if delay, ok := g.retryPolicy.retry(attempt, true, resp, nil); ok {
+			resp.Body.Close()
+			if err := internal.Sleep(ctx, delay); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		apiErr := newAPIError(opSynthesizeEvents, resp)
+		resp.Body.Close()
+		return nil, apiErr
+	}
+}
+
+// wsError ctx 取消导致的连接关闭返回 ctx.Err()，服务端关闭连接返回 *WebSocketError
+func wsError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	var ce *websocket.CloseError
+	if errors.As(err, &ce) {
+		return &WebSocketError{Code: ce.Code, Reason: ce.Reason}
+	}
+	return err
+}
+
+// speechConfig 客户端信息，与官方 SDK 的 speech.config 消息一致
+var speechConfig = []byte(`{"context":{"system":{"name":"SpeechSDK","version":"1.0.0","build":"Go","lang":"Go"},"os":{"platform":"Go","name":"go-micro-tts","version":"1.0.0"}}}`)
+
+func synthesisContext(req *EventSynthesizeReq) []byte {
+	v := map[string]any{
+		"synthesis": map[string]any{
+			"audio": map[string]any{
+				"metadataOptions": map[string]any{
+					"wordBoundaryEnabled":        req.WordBoundary,
+					"sentenceBoundaryEnabled":    req.SentenceBoundary,
+					"punctuationBoundaryEnabled": req.PunctuationBoundary,
+					"visemeEnabled":              req.Viseme,
+					"bookmarkEnabled":            req.Bookmark,
+					"sessionEndEnabled":          true,
+				},
+				"outputFormat": string(req.OutputFormat),
+			},
+			"language": map[string]any{"autoDetection": false},
+		},
+	}
+	data, _ := json.Marshal(v)
+	return data
+}
+
+// wsTextMessage 文本消息由 HTTP 风格的消息头、空行及消息体组成
+func wsTextMessage(path, reqId, contentType string, body []byte) []byte {
+	buf := &bytes.Buffer{}
+	buf.WriteString("X-Timestamp:" + time.Now().UTC().Format("2006-01-02T15:04:05.000Z") + "\r\n")
+	buf.WriteString("Path:" + path + "\r\n")
+	buf.WriteString("X-RequestId:" + reqId + "\r\n")
+	buf.WriteString("Content-Type:" + contentType + "\r\n\r\n")
+	buf.Write(body)
+	return buf.Bytes()
+}
+
+func parseWsTextMessage(data []byte) (path string, body []byte) {
+	head, body, _ := bytes.Cut(data, []byte("\r\n\r\n"))
+	return wsHeaderPath(head), body
+}
+
+// parseWsBinaryMessage 二进制消息以 2 字节大端序的消息头长度开头，之后是消息头及音频数据
+func parseWsBinaryMessage(data []byte) (path string, body []byte, err error) {
+	if len(data) < 2 {
+		return "", nil, errors.New("websocket: binary message too short")
+	}
+	n := int(binary.BigEndian.Uint16(data))
+	if len(data) < 2+n {
+		return "", nil, errors.New("websocket: binary message header too long")
+	}
+	return wsHeaderPath(data[2 : 2+n]), data[2+n:], nil
+}
+
+func wsHeaderPath(head []byte) string {
+	for _, line := range strings.Split(string(head), "\r\n") {
+		k, v, ok := strings.Cut(line, ":")
+		if ok && strings.EqualFold(strings.TrimSpace(k), "Path") {
+			return strings.TrimSpace(v)
+		}
+	}
+	return ""
+}
+
+// audioMetadata audio.metadata 消息，时间单位为 tick（100 纳秒）
+type audioMetadata struct {
+	Metadata []struct {
+		Type string `json:"Type"`
+		Data struct {
+			Offset   float64 `json:"Offset"`
+			Duration float64 `json:"Duration"`
+			Text     struct {
+				Text         string `json:"Text"`
+				Length       int    `json:"Length"`
+				BoundaryType string `json:"BoundaryType"`
+			} `json:"text"`
+			VisemeId       int    `json:"VisemeId"`
+			AnimationChunk string `json:"AnimationChunk"`
+			Bookmark       string `json:"Bookmark"`
+		} `json:"Data"`
+	} `json:"Metadata"`
+}
+
+func parseAudioMetadata(body []byte) ([]*SynthesisEvent, error) {
+	m := &audioMetadata{}
+	if err := json.Unmarshal(body, m); err != nil {
+		return nil, fmt.Errorf("audio.metadata: %w", err)
+	}
+
+	var events []*SynthesisEvent
+	for _, v := range m.Metadata {
+		ev := &SynthesisEvent{Offset: ticksToDuration(v.Data.Offset)}
+		switch v.Type {
+		case "WordBoundary", "SentenceBoundary":
+			b := &Boundary{
+				Text:       v.Data.Text.Text,
+				Offset:     ev.Offset,
+				Duration:   ticksToDuration(v.Data.Duration),
+				TextOffset: -1,
+			}
+			boundaryType := v.Data.Text.BoundaryType
+			if boundaryType == "" {
+				boundaryType = v.Type
+			}
+			switch boundaryType {
+			case "SentenceBoundary":
+				ev.Type, b.Kind = EventSentenceBoundary, BoundarySentence
+			case "PunctuationBoundary":
+				ev.Type, b.Kind = EventPunctuationBoundary, BoundaryPunctuation
+			default:
+				ev.Type, b.Kind = EventWordBoundary, BoundaryWord
+			}
+			ev.Boundary = b
+		case "Viseme":
+			ev.Type = EventViseme
+			ev.Viseme = &Viseme{Id: v.Data.VisemeId, Offset: ev.Offset, Animation: v.Data.AnimationChunk}
+		case "Bookmark":
+			ev.Type, ev.Bookmark = EventBookmark, v.Data.Bookmark
+		case "SessionEnd":
+			ev.Type = EventSessionEnd
+		default:
+			continue
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
+
+// newRequestId 32 位十六进制的请求 ID
+func newRequestId() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
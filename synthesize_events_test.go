@@ -0,0 +1,155 @@
+package go_micro_tts
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/xuemingjings/go-micro-tts/ttstest"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestEventReq() *EventSynthesizeReq {
+	return &EventSynthesizeReq{
+		OutputFormat: Audio24kHz48KbitrateMonoMp3,
+		Ssml: NewSsmlSpeak("en-US").Voice("en-US-JennyNeural",
+			SsmlText("Hello world. "), SsmlBookmark("mark1"), SsmlText("How are you?")),
+		WordBoundary:     true,
+		SentenceBoundary: true,
+		Viseme:           true,
+		Bookmark:         true,
+	}
+}
+
+func TestSynthesizeEvents(t *testing.T) {
+	tts, srv := newTestTTS(t)
+	req := newTestEventReq()
+
+	audio := &bytes.Buffer{}
+	var words, sentences []string
+	var visemes int
+	var bookmark *SynthesisEvent
+	var last SynthesisEventType
+	err := tts.SynthesizeEvents(context.TODO(), req, func(ev *SynthesisEvent) error {
+		switch ev.Type {
+		case EventAudio:
+			audio.Write(ev.Audio)
+		case EventWordBoundary:
+			words = append(words, ev.Boundary.Text)
+		case EventSentenceBoundary:
+			sentences = append(sentences, ev.Boundary.Text)
+		case EventViseme:
+			visemes++
+		case EventBookmark:
+			bookmark = ev
+		}
+		last = ev.Type
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("实时合成报错 err:%v", err)
+	}
+
+	ssml, _ := req.Ssml.Ssml()
+	if audio.String() != string(req.OutputFormat)+"\n"+string(ssml) {
+		t.Errorf("audio = %q", audio.String())
+	}
+	if strings.Join(words, " ") != "Hello world How are you" || visemes != len(words) {
+		t.Errorf("words = %v, visemes = %d", words, visemes)
+	}
+	if len(sentences) != 2 || sentences[1] != "How are you?" {
+		t.Errorf("sentences = %v", sentences)
+	}
+	if bookmark == nil || bookmark.Bookmark != "mark1" || bookmark.Offset != 600*time.Millisecond {
+		t.Errorf("bookmark = %+v", bookmark)
+	}
+	if last != EventSessionEnd {
+		t.Errorf("最后一个事件 = %s", last)
+	}
+	if n := srv.Requests(ttstest.PathWebSocket); n != 1 {
+		t.Errorf("请求次数 = %d", n)
+	}
+}
+
+func TestStreamEvents(t *testing.T) {
+	tts, _ := newTestTTS(t)
+
+	stream := tts.StreamEvents(context.TODO(), newTestEventReq())
+	defer stream.Close()
+
+	n := 0
+	for ev := range stream.Events() {
+		if ev.Type == EventWordBoundary && ev.Boundary.Kind != BoundaryWord {
+			t.Errorf("ev = %+v", ev)
+		}
+		n++
+	}
+	if err := stream.Err(); err != nil || n == 0 {
+		t.Errorf("events = %d, err:%v", n, err)
+	}
+
+	// 提前关闭
+	stream = tts.StreamEvents(context.TODO(), newTestEventReq())
+	<-stream.Events()
+	stream.Close()
+	if err := stream.Err(); !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestSynthesizeEventsErrors(t *testing.T) {
+	tts, srv := newTestTTS(t)
+
+	// 令牌过期时刷新后重试
+	if err := tts.SynthesizeEvents(context.TODO(), newTestEventReq(), func(*SynthesisEvent) error { return nil }); err != nil {
+		t.Fatalf("实时合成报错 err:%v", err)
+	}
+	srv.ExpireTokens()
+	if err := tts.SynthesizeEvents(context.TODO(), newTestEventReq(), func(*SynthesisEvent) error { return nil }); err != nil {
+		t.Fatalf("令牌过期后实时合成报错 err:%v", err)
+	}
+
+	// 握手失败
+	srv.Fail(ttstest.PathWebSocket, 1, http.StatusTooManyRequests, "TooManyRequests", "slow down")
+	err := tts.SynthesizeEvents(context.TODO(), newTestEventReq(), func(*SynthesisEvent) error { return nil })
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || !errors.Is(err, ErrThrottled) || apiErr.Op != opSynthesizeEvents {
+		t.Errorf("err = %v", err)
+	}
+
+	// 服务端以 1007 关闭连接
+	req := &EventSynthesizeReq{OutputFormat: Audio24kHz48KbitrateMonoMp3, Ssml: rawSsml("<speak>")}
+	err = tts.SynthesizeEvents(context.TODO(), req, func(*SynthesisEvent) error { return nil })
+	var wsErr *WebSocketError
+	if !errors.As(err, &wsErr) || wsErr.Code != 1007 || !errors.Is(err, ErrInvalidSSML) {
+		t.Errorf("err = %v", err)
+	}
+
+	// 回调返回错误时中止
+	stop := errors.New("stop")
+	err = tts.SynthesizeEvents(context.TODO(), newTestEventReq(), func(*SynthesisEvent) error { return stop })
+	if err != stop {
+		t.Errorf("err = %v", err)
+	}
+
+	// 未传入请求或朗读内容
+	for _, req := range []*EventSynthesizeReq{nil, {OutputFormat: Audio24kHz48KbitrateMonoMp3}} {
+		if err := tts.SynthesizeEvents(context.TODO(), req, func(*SynthesisEvent) error { return nil }); !errors.Is(err, errNoSynthesizeReq) {
+			t.Errorf("err = %v", err)
+		}
+		stream := tts.StreamEvents(context.TODO(), req)
+		if _, ok := <-stream.Events(); ok || !errors.Is(stream.Err(), errNoSynthesizeReq) {
+			t.Errorf("stream err = %v", stream.Err())
+		}
+		stream.Close()
+	}
+}
+
+// rawSsml 原样发送的 SSML
+type rawSsml string
+
+func (s rawSsml) Ssml() ([]byte, error) {
+	return []byte(s), nil
+}
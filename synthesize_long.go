@@ -0,0 +1,169 @@
+package go_micro_tts
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/xuemingjings/go-micro-tts/audio"
+	"github.com/xuemingjings/go-micro-tts/internal"
+	"io"
+	"sync"
+	"time"
+)
+
+// DefaultLongConcurrency SynthesizeLong 默认的并发请求数
+const DefaultLongConcurrency = 4
+
+// ErrStitchFormat 输出格式的音频无法拼接，与 audio.ErrUnsupported 相同
+var ErrStitchFormat = audio.ErrUnsupported
+
+// LongSynthesizeReq 长文本转语音请求
+type LongSynthesizeReq struct {
+	OutputFormat SsmlOut       // 音频输出格式
+	Ssml         SsmlDocument  // 朗读内容
+	MaxChunkSize int           // 每段的最大字数，为 0 时为 DefaultSsmlChunkSize，参见 SplitSSML
+	Concurrency  int           // 并发请求数，为 0 时为 DefaultLongConcurrency
+	Gap          time.Duration // 各段之间插入的静音时长，mp3、ogg、webm、amr 按编码帧（20ms 等）四舍五入，参见 audio.Format.SilenceFrame
+}
+
+// SynthesizeLong 长文本转语音：按句子拆分 SSML 后并发调用 TextToVoice，并按顺序将音频拼接写入 w，返回写入的字节数
+// 用于超出 REST 接口 10 分钟音频限制、又不便等待批处理合成的场景
+// 已合成的音频按顺序边合成边写入，同时最多缓存 Concurrency 段；任一段失败时取消其余请求并返回错误
+// 各段由 audio.Writer 按容器格式拼接，参见 audio 包；riff 格式仅在 w 实现了 io.WriterAt 时回填文件头中的长度
+func (g *GoTTS) SynthesizeLong(ctx context.Context, req *LongSynthesizeReq, w io.Writer) (int64, error) {
+	if req == nil || req.Ssml == nil {
+		return 0, errNoSynthesizeReq
+	}
+	format, err := audio.ParseFormat(string(req.OutputFormat))
+	if err != nil {
+		return 0, err
+	}
+	// 在发送请求前检查，以免合成后才发现无法插入静音
+	if req.Gap > 0 {
+		if !format.SupportsSilence() {
+			return 0, fmt.Errorf("%w: silence gap for %s", ErrStitchFormat, req.OutputFormat)
+		}
+		if frame := format.SilenceFrame(); frame > 0 && (req.Gap+frame/2)/frame == 0 {
+			return 0, fmt.Errorf("%w: gap %s, frame %s", audio.ErrSilenceTooShort, req.Gap, frame)
+		}
+	}
+	cw := &countWriter{w: w}
+	var out io.Writer = cw
+	if wa, ok := w.(io.WriterAt); ok {
+		out = struct {
+			io.Writer
+			io.WriterAt
+		}{cw, wa}
+	}
+	aw, err := audio.NewWriter(out, format)
+	if err != nil {
+		return 0, err
+	}
+
+	data, err := req.Ssml.Ssml()
+	if err != nil {
+		return 0, err
+	}
+	chunks, err := SplitSSML(data, req.MaxChunkSize)
+	if err != nil {
+		return 0, err
+	}
+
+	concurrency := req.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultLongConcurrency
+	}
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		audio []byte
+		err   error
+	}
+	results := make([]chan result, len(chunks))
+	for i := range results {
+		results[i] = make(chan result, 1)
+	}
+
+	// 写入一段后才释放一个名额，因此最多缓存 concurrency 段音频
+	sem := make(chan struct{}, concurrency)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i, chunk := range chunks {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			wg.Add(1)
+			go func(i int, chunk []byte) {
+				defer wg.Done()
+				buf := &bytes.Buffer{}
+				_, err := g.TextToVoiceTo(ctx, req.OutputFormat, ssmlBytes(chunk), buf)
+				if err != nil {
+					err = fmt.Errorf("chunk %d/%d: %w", i+1, len(chunks), err)
+				}
+				results[i] <- result{audio: buf.Bytes(), err: err}
+			}(i, chunk)
+		}
+	}()
+
+	for i := range results {
+		var r result
+		select {
+		case r = <-results[i]:
+		case <-ctx.Done():
+			return cw.n, ctx.Err()
+		}
+		if r.err != nil {
+			return cw.n, r.err
+		}
+
+		if i > 0 {
+			if err := aw.WriteSilence(req.Gap); err != nil {
+				return cw.n, err
+			}
+		}
+		if err := aw.WriteSegment(r.audio); err != nil {
+			return cw.n, fmt.Errorf("chunk %d/%d: %w", i+1, len(chunks), err)
+		}
+		<-sem
+	}
+	err = aw.Close()
+	return cw.n, err
+}
+
+// SynthesizeLongFile 长文本转语音并写入文件 path，返回写入的字节数，全部成功后才会生成 path
+// path 没有扩展名时按 req.OutputFormat 补全，同 TextToVoiceFile
+func (g *GoTTS) SynthesizeLongFile(ctx context.Context, req *LongSynthesizeReq, path string) (int64, error) {
+	if req == nil || req.Ssml == nil {
+		return 0, errNoSynthesizeReq
+	}
+	return internal.WriteFileAtomic(req.OutputFormat.FileName(path), 0o644, func(w io.Writer) (int64, error) {
+		return g.SynthesizeLong(ctx, req, w)
+	})
+}
+
+// ssmlBytes 原样提交的 SSML
+type ssmlBytes []byte
+
+func (s ssmlBytes) Ssml() ([]byte, error) {
+	return s, nil
+}
+
+// countWriter 统计写入的字节数
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
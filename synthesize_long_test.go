@@ -0,0 +1,150 @@
+package go_micro_tts
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/xuemingjings/go-micro-tts/audio"
+	"github.com/xuemingjings/go-micro-tts/ttstest"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestLongReq(format SsmlOut) *LongSynthesizeReq {
+	return &LongSynthesizeReq{
+		OutputFormat: format,
+		Ssml: NewSsmlSpeak("zh-CN").Voice("zh-CN-XiaoxiaoNeural",
+			SsmlText(strings.Repeat("今天天气很好。", 10))),
+		MaxChunkSize: 15,
+		Concurrency:  3,
+	}
+}
+
+func TestSynthesizeLong(t *testing.T) {
+	tts, srv := newTestTTS(t)
+	req := newTestLongReq(Audio24kHz48KbitrateMonoMp3)
+
+	ssml, _ := req.Ssml.Ssml()
+	chunks, _ := SplitSSML(ssml, req.MaxChunkSize)
+	want := &bytes.Buffer{}
+	for _, chunk := range chunks {
+		want.WriteString(string(req.OutputFormat) + "\n" + string(chunk))
+	}
+
+	buf := &bytes.Buffer{}
+	n, err := tts.SynthesizeLong(context.TODO(), req, buf)
+	if err != nil {
+		t.Fatalf("长文本合成报错 err:%v", err)
+	}
+	if len(chunks) != 5 || srv.Requests(ttstest.PathSynthesis) != len(chunks) {
+		t.Errorf("chunks = %d, requests = %d", len(chunks), srv.Requests(ttstest.PathSynthesis))
+	}
+	if n != int64(buf.Len()) || buf.String() != want.String() {
+		t.Errorf("音频未按顺序拼接: %q", buf.String())
+	}
+
+	path := filepath.Join(t.TempDir(), "long.mp3")
+	if _, err := tts.SynthesizeLongFile(context.TODO(), req, path); err != nil {
+		t.Fatalf("写入文件报错 err:%v", err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != want.String() {
+		t.Errorf("文件内容 = %q", data)
+	}
+
+	// 没有扩展名时按输出格式补全
+	path = strings.TrimSuffix(path, ".mp3")
+	if _, err := tts.SynthesizeLongFile(context.TODO(), req, path); err != nil {
+		t.Fatalf("写入文件报错 err:%v", err)
+	}
+	if data, _ := os.ReadFile(path + ".mp3"); string(data) != want.String() {
+		t.Errorf("补全扩展名后的文件内容 = %q", data)
+	}
+	if _, err := tts.SynthesizeLongFile(context.TODO(), nil, path); !errors.Is(err, errNoSynthesizeReq) {
+		t.Errorf("req 为 nil 时 err = %v", err)
+	}
+}
+
+func TestSynthesizeLongAmr(t *testing.T) {
+	tts, _ := newTestTTS(t, ttstest.WithAudio(func(format string, ssml []byte) []byte {
+		return []byte("#!AMR-WB\n" + "frame")
+	}))
+
+	buf := &bytes.Buffer{}
+	if _, err := tts.SynthesizeLong(context.TODO(), newTestLongReq(AmrWb16000Hz), buf); err != nil {
+		t.Fatalf("长文本合成报错 err:%v", err)
+	}
+	if buf.String() != "#!AMR-WB\n"+strings.Repeat("frame", 5) {
+		t.Errorf("amr = %q", buf.String())
+	}
+}
+
+func TestSynthesizeLongErrors(t *testing.T) {
+	tts, srv := newTestTTS(t)
+
+	_, err := tts.SynthesizeLong(context.TODO(), newTestLongReq("flac-24khz-16bit-mono-flac"), &bytes.Buffer{})
+	if !errors.Is(err, ErrStitchFormat) {
+		t.Errorf("flac err = %v", err)
+	}
+
+	for _, req := range []*LongSynthesizeReq{nil, {OutputFormat: Audio24kHz48KbitrateMonoMp3}} {
+		if _, err := tts.SynthesizeLong(context.TODO(), req, &bytes.Buffer{}); !errors.Is(err, errNoSynthesizeReq) {
+			t.Errorf("err = %v", err)
+		}
+	}
+
+	// 无法插入静音的格式在发送请求前返回错误
+	req := newTestLongReq(Raw16kHz16BitMonoTruesilk)
+	req.Gap = time.Second
+	if _, err := tts.SynthesizeLong(context.TODO(), req, &bytes.Buffer{}); !errors.Is(err, ErrStitchFormat) {
+		t.Errorf("truesilk gap err = %v", err)
+	}
+	// 不足半帧的静音无法表示
+	req = newTestLongReq(Ogg24kHz16BitMonoOpus)
+	req.Gap = 5 * time.Millisecond
+	if _, err := tts.SynthesizeLong(context.TODO(), req, &bytes.Buffer{}); !errors.Is(err, audio.ErrSilenceTooShort) {
+		t.Errorf("5ms gap err = %v", err)
+	}
+	if n := srv.Requests(ttstest.PathSynthesis); n != 0 {
+		t.Errorf("合成请求次数 = %d, 期望 0", n)
+	}
+
+	// 任一段失败时返回错误，且不写入文件
+	srv.Fail(ttstest.PathSynthesis, 1, http.StatusBadRequest, "InvalidSsml", "bad ssml")
+	path := filepath.Join(t.TempDir(), "long.mp3")
+	_, err = tts.SynthesizeLongFile(context.TODO(), newTestLongReq(Audio24kHz48KbitrateMonoMp3), path)
+	if !errors.Is(err, ErrInvalidSSML) {
+		t.Errorf("err = %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("失败时不应生成文件 err:%v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	if _, err := tts.SynthesizeLong(ctx, newTestLongReq(Audio24kHz48KbitrateMonoMp3), &bytes.Buffer{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("取消时 err = %v", err)
+	}
+}
+
+func TestSynthesizeLongGap(t *testing.T) {
+	tts, _ := newTestTTS(t, ttstest.WithAudio(func(format string, ssml []byte) []byte {
+		return []byte{1, 1}
+	}))
+	req := newTestLongReq(Raw8kHz16BitMonoPcm)
+	req.Gap = time.Millisecond
+
+	buf := &bytes.Buffer{}
+	n, err := tts.SynthesizeLong(context.TODO(), req, buf)
+	if err != nil {
+		t.Fatalf("长文本合成报错 err:%v", err)
+	}
+	// 5 段音频之间各插入 1ms（8 个采样，16 字节）的静音
+	want := bytes.Repeat(append([]byte{1, 1}, make([]byte, 16)...), 5)[:5*18-16]
+	if n != int64(len(want)) || !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("audio = %v", buf.Bytes())
+	}
+}
@@ -0,0 +1,175 @@
+package go_micro_tts
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/xuemingjings/go-micro-tts/audio"
+	"github.com/xuemingjings/go-micro-tts/ttstest"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSynthesize(t *testing.T) {
+	tts, _ := newTestTTS(t)
+
+	doc := NewSsmlSpeak("zh-CN").Voice("zh-CN-YunxiNeural", SsmlText("你好"))
+	audio, info, err := tts.Synthesize(context.TODO(), &SynthesizeReq{
+		OutputFormat: Audio24kHz48KbitrateMonoMp3,
+		Ssml:         doc,
+	})
+	if err != nil {
+		t.Fatalf("Synthesize报错 err:%v", err)
+	}
+	defer audio.Close()
+
+	data, err := io.ReadAll(audio)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(data), string(Audio24kHz48KbitrateMonoMp3)+"\n") {
+		t.Errorf("音频数据 = %s", data)
+	}
+
+	if info.SampleRate != 24000 || info.ContentType != "audio/mpeg" || info.RequestId == "" {
+		t.Errorf("info = %+v", info)
+	}
+	if info.ContentLength != -1 && info.ContentLength != int64(len(data)) {
+		t.Errorf("ContentLength = %d, 实际 %d", info.ContentLength, len(data))
+	}
+
+	if err := audio.Close(); err != nil {
+		t.Errorf("重复 Close 报错 err:%v", err)
+	}
+}
+
+func TestSynthesizeEmptyAudio(t *testing.T) {
+	tts, _ := newTestTTS(t, ttstest.WithAudio(func(format string, ssml []byte) []byte {
+		return nil
+	}))
+
+	doc := NewSsmlSpeak("zh-CN").Voice("zh-CN-YunxiNeural", SsmlText("你好"))
+	_, _, err := tts.Synthesize(context.TODO(), &SynthesizeReq{OutputFormat: Raw16kHz16BitMonoPcm, Ssml: doc})
+	if !errors.Is(err, ErrEmptyAudio) {
+		t.Fatalf("err = %v, 期望 ErrEmptyAudio", err)
+	}
+}
+
+func TestSsmlOutSampleRate(t *testing.T) {
+	for format, want := range map[SsmlOut]int{
+		AmrWb16000Hz:                 16000,
+		Audio48kHz192KbitrateMonoMp3: 48000,
+		Raw22050Hz16BitMonoPcm:       22050,
+		Raw8kHz8BitMonoMulaw:         8000,
+		Riff44100Hz16BitMonoPcm:      44100,
+		Webm24kHz16BitMonoOpus:       24000,
+	} {
+		if got := format.Info().SampleRate; got != want {
+			t.Errorf("%s sampleRate = %d, 期望 %d", format, got, want)
+		}
+	}
+}
+
+func TestWavReader(t *testing.T) {
+	tts, _ := newTestTTS(t, ttstest.WithAudio(func(format string, ssml []byte) []byte {
+		return []byte{1, 2, 3, 4}
+	}))
+
+	doc := NewSsmlSpeak("zh-CN").Voice("zh-CN-YunxiNeural", SsmlText("你好"))
+	rc, info, err := tts.Synthesize(context.TODO(), &SynthesizeReq{OutputFormat: Raw8kHz16BitMonoPcm, Ssml: doc})
+	if err != nil {
+		t.Fatalf("Synthesize报错 err:%v", err)
+	}
+	defer rc.Close()
+
+	wav, err := WavReader(rc, info)
+	if err != nil {
+		t.Fatalf("WavReader报错 err:%v", err)
+	}
+	data, _ := io.ReadAll(wav)
+	if len(data) != 48 || string(data[:4]) != "RIFF" || string(data[8:16]) != "WAVEfmt " || !bytes.Equal(data[44:], []byte{1, 2, 3, 4}) {
+		t.Errorf("wav = %v", data)
+	}
+
+	if _, err := WavReader(rc, &AudioInfo{OutputFormat: Audio24kHz48KbitrateMonoMp3}); !errors.Is(err, audio.ErrUnsupported) {
+		t.Errorf("mp3 err = %v", err)
+	}
+}
+
+func TestSynthesizeError(t *testing.T) {
+	tts, srv := newTestTTS(t)
+	srv.Throttle(ttstest.PathSynthesis, 1, 0)
+
+	doc := NewSsmlSpeak("zh-CN").Voice("zh-CN-YunxiNeural", SsmlText("你好"))
+	_, _, err := tts.Synthesize(context.TODO(), &SynthesizeReq{OutputFormat: Raw16kHz16BitMonoPcm, Ssml: doc})
+	if !errors.Is(err, ErrThrottled) {
+		t.Fatalf("err = %v, 期望 ErrThrottled", err)
+	}
+}
+
+func TestTextToVoiceTo(t *testing.T) {
+	tts, _ := newTestTTS(t)
+
+	buf := &bytes.Buffer{}
+	doc := NewSsmlSpeak("zh-CN").Voice("zh-CN-YunxiNeural", SsmlText("你好"))
+	n, err := tts.TextToVoiceTo(context.TODO(), Audio16kHz32KbitrateMonoMp3, doc, buf)
+	if err != nil {
+		t.Fatalf("TextToVoiceTo报错 err:%v", err)
+	}
+	if n == 0 || n != int64(buf.Len()) {
+		t.Errorf("写入字节数 = %d, 实际 %d", n, buf.Len())
+	}
+}
+
+func TestTextToVoiceFile(t *testing.T) {
+	tts, srv := newTestTTS(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "out.mp3")
+
+	doc := NewSsmlSpeak("zh-CN").Voice("zh-CN-YunxiNeural", SsmlText("你好"))
+	n, err := tts.TextToVoiceFile(context.TODO(), Audio16kHz32KbitrateMonoMp3, doc, path)
+	if err != nil {
+		t.Fatalf("TextToVoiceFile报错 err:%v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil || info.Size() != n {
+		t.Fatalf("文件大小 = %v, 写入字节数 = %d, err:%v", info, n, err)
+	}
+
+	// 合成失败时不应出现目标文件及临时文件
+	failPath := filepath.Join(dir, "fail.mp3")
+	srv.Fail(ttstest.PathSynthesis, 1, http.StatusBadRequest, "BadRequest", "")
+	if _, err := tts.TextToVoiceFile(context.TODO(), Audio16kHz32KbitrateMonoMp3, doc, failPath); err == nil {
+		t.Fatal("合成失败时应返回错误")
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 || entries[0].Name() != "out.mp3" {
+		t.Errorf("目录中的文件 = %v", entries)
+	}
+
+	// 没有扩展名时按输出格式补全
+	if _, err := tts.TextToVoiceFile(context.TODO(), Ogg24kHz16BitMonoOpus, doc, filepath.Join(dir, "article")); err != nil {
+		t.Fatalf("TextToVoiceFile报错 err:%v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "article.ogg")); err != nil {
+		t.Errorf("未补全扩展名 err:%v", err)
+	}
+}
+
+func TestSynthesizeNilReq(t *testing.T) {
+	tts, srv := newTestTTS(t)
+
+	if _, _, err := tts.Synthesize(context.TODO(), nil); err == nil {
+		t.Error("req 为 nil 时应返回错误")
+	}
+	if _, _, err := tts.Synthesize(context.TODO(), &SynthesizeReq{OutputFormat: Raw16kHz16BitMonoPcm}); err == nil {
+		t.Error("Ssml 为 nil 时应返回错误")
+	}
+	if n := srv.Requests(""); n != 0 {
+		t.Errorf("请求次数 = %d, 期望 0", n)
+	}
+}
@@ -0,0 +1,116 @@
+package go_micro_tts
+
+import (
+	"context"
+	"github.com/xuemingjings/go-micro-tts/internal"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// tokenTTL 微软颁发的访问令牌有效期为 10 分钟
+	tokenTTL = 10 * time.Minute
+	// tokenRefreshAhead 在令牌过期前提前刷新的时间
+	tokenRefreshAhead = time.Minute
+)
+
+// tokenState 访问令牌的缓存状态
+type tokenState struct {
+	mu       sync.Mutex
+	issuedAt time.Time  // 令牌颁发时间
+	call     *tokenCall // 正在进行中的刷新请求
+}
+
+// tokenCall 一次刷新令牌的请求，并发调用方共享同一个结果
+type tokenCall struct {
+	done  chan struct{}
+	token string
+	err   error
+}
+
+// getToken 获取可用的访问令牌
+// stale 为调用方已确认失效的令牌（例如收到 401），若当前缓存的正是该令牌则强制刷新
+// 刷新请求使用与发起刷新的调用方 ctx 分离的上下文（保留其中的值，超时为 tokenTimeout），
+// 单个调用方取消 ctx 只会停止等待，不会中断其他调用方共享的刷新
+func (g *GoTTS) getToken(ctx context.Context, stale string) (string, error) {
+	ts := &g.tokenState
+
+	ts.mu.Lock()
+	if g.token != "" && g.token != stale && time.Since(ts.issuedAt) < tokenTTL-tokenRefreshAhead {
+		token := g.token
+		ts.mu.Unlock()
+		return token, nil
+	}
+
+	// 没有进行中的刷新请求时发起刷新，否则等待其结果
+	c := ts.call
+	if c == nil {
+		c = &tokenCall{done: make(chan struct{})}
+		ts.call = c
+		go g.refreshToken(context.WithoutCancel(ctx), c)
+	}
+	ts.mu.Unlock()
+
+	select {
+	case <-c.done:
+		return c.token, c.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// refreshToken 执行一次刷新并通知所有等待的调用方
+func (g *GoTTS) refreshToken(ctx context.Context, c *tokenCall) {
+	ts := &g.tokenState
+
+	if g.tokenTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, g.tokenTimeout)
+		defer cancel()
+	}
+	c.token, c.err = g.issueToken(ctx)
+
+	ts.mu.Lock()
+	if c.err == nil {
+		g.token = c.token
+		ts.issuedAt = time.Now()
+	}
+	ts.call = nil
+	ts.mu.Unlock()
+	close(c.done)
+}
+
+// issueToken 向 STS 服务申请新的访问令牌
+func (g *GoTTS) issueToken(ctx context.Context) (string, error) {
+	uri := g.tokenEndpoint + apiToken
+
+	header := map[string]any{
+		"Ocp-Apim-Subscription-Key": g.speechKey,
+	}
+
+	client := g.newHTTPClient(
+		ctx,
+		g.tokenTimeout,
+		internal.WithHeader(header),
+		internal.WithContentType(internal.HttpFormUrlencoded),
+		internal.WithIdempotent(true),
+	)
+	resp, funcClose, err := client.SendRequest(http.MethodPost, uri, nil)
+	defer funcClose()
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", newAPIError(opIssueToken, resp)
+	}
+
+	req, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	return string(req), nil
+}
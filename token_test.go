@@ -0,0 +1,82 @@
+package go_micro_tts
+
+import (
+	"context"
+	"github.com/xuemingjings/go-micro-tts/ttstest"
+	"sync"
+	"testing"
+)
+
+func TestTokenRefreshOnUnauthorized(t *testing.T) {
+	tts, srv := newTestTTS(t)
+	ssml := NewSpeakXml(&SpeakXmlReq{Lang: "zh-CN", Gender: "Male", Name: "zh-CN-YunxiNeural", Text: "你好"})
+
+	for i := 0; i < 2; i++ {
+		_, funcClose, err := tts.TextToVoice(Audio16kHz32KbitrateMonoMp3, ssml)
+		funcClose()
+		if err != nil {
+			t.Fatalf("第%d次TextToVoice报错 err:%v", i+1, err)
+		}
+		// 令牌在服务端失效后，下一次调用应自动刷新令牌并重试
+		srv.ExpireTokens()
+	}
+
+	if n := srv.Requests(ttstest.PathToken); n != 2 {
+		t.Errorf("token请求次数 = %d, 期望 2", n)
+	}
+	if n := srv.Requests(ttstest.PathSynthesis); n != 3 {
+		t.Errorf("合成请求次数 = %d, 期望 3", n)
+	}
+}
+
+func TestTokenRefreshConcurrent(t *testing.T) {
+	tts, srv := newTestTTS(t)
+	ssml := NewSpeakXml(&SpeakXmlReq{Lang: "zh-CN", Gender: "Male", Name: "zh-CN-YunxiNeural", Text: "你好"})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, funcClose, err := tts.TextToVoice(Audio16kHz32KbitrateMonoMp3, ssml)
+			funcClose()
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("TextToVoice报错 err:%v", err)
+		}
+	}
+	if n := srv.Requests(ttstest.PathToken); n != 1 {
+		t.Errorf("并发调用时token请求次数 = %d, 期望 1", n)
+	}
+}
+
+func TestTokenRefreshAfterConstructorCancel(t *testing.T) {
+	srv := ttstest.NewServer()
+	t.Cleanup(srv.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	tts, err := NewGoTTS(
+		ctx,
+		WithSpeechKey(srv.SpeechKey),
+		WithEndpoint(srv.URL),
+		WithTokenEndpoint(srv.URL),
+		WithBatchEndpoint(srv.URL),
+		WithHTTPClient(srv.Client()),
+	)
+	if err != nil {
+		t.Fatalf("初始化报错 err:%v", err)
+	}
+	// NewGoTTS 的 ctx 取消后，调用方使用有效的 ctx 仍可刷新令牌
+	cancel()
+
+	if _, err := tts.getToken(context.TODO(), ""); err != nil {
+		t.Fatalf("获取token报错 err:%v", err)
+	}
+}
@@ -14,36 +14,67 @@ import (
 )
 
 const (
-	apiToken           = "https://%s.api.cognitive.microsoft.com/sts/v1.0/issueToken"
-	apiVoiceList       = "https://%s.tts.speech.microsoft.com/cognitiveservices/voices/list"
-	apiTextToVoice     = "https://%s.tts.speech.microsoft.com/cognitiveservices/v1"
-	apiLongTextToVoice = "https://%s.customvoice.api.speech.microsoft.com/api/texttospeech/3.1-preview1/batchsynthesis"
+	apiToken           = "/sts/v1.0/issueToken"
+	apiVoiceList       = "/cognitiveservices/voices/list"
+	apiTextToVoice     = "/cognitiveservices/v1"
+	apiLongTextToVoice = "/api/texttospeech/3.1-preview1/batchsynthesis"
+	apiWebSocket       = "/cognitiveservices/websocket/v1"
 )
 
 type GoTTS struct {
-	ctx          context.Context
-	speechKey    string // SPEECH_KEY 必填
-	speechRegion string // SPEECH_REGION 必填
-	token        string // 自动生成
+	ctx           context.Context
+	speechKey     string // SPEECH_KEY 必填
+	speechRegion  string // SPEECH_REGION 未自定义全部服务地址时必填
+	endpoint      string // 语音合成服务地址
+	tokenEndpoint string // 访问令牌服务地址
+	batchEndpoint string // 批处理合成服务地址
+
+	httpClient       *http.Client      // 所有请求共享的 http.Client
+	transport        http.RoundTripper // 自定义 Transport
+	tokenTimeout     time.Duration
+	voiceListTimeout time.Duration
+	synthesisTimeout time.Duration
+	batchTimeout     time.Duration
+	retryPolicy      RetryPolicy
+	ssmlValidate     *SsmlValidateOptions
+	durationLimit    time.Duration // TextToVoice 估算音频时长的上限
+	voiceCache       voiceCache
+
+	token      string // 自动生成
+	tokenState tokenState
 }
 
 type Option func(*GoTTS)
 
 func NewGoTTS(ctx context.Context, opts ...Option) (*GoTTS, error) {
-	g := &GoTTS{ctx: ctx}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	g := &GoTTS{
+		ctx:              ctx,
+		tokenTimeout:     defaultTokenTimeout,
+		voiceListTimeout: defaultVoiceListTimeout,
+		synthesisTimeout: defaultSynthesisTimeout,
+		batchTimeout:     defaultBatchTimeout,
+		voiceCache:       voiceCache{ttl: defaultVoiceCacheTTL},
+	}
 
 	for _, o := range opts {
 		o(g)
 	}
 
 	// 参数验证
-	if g.speechRegion == "" {
+	if g.speechRegion == "" && (g.endpoint == "" || g.tokenEndpoint == "" || g.batchEndpoint == "") {
 		return nil, errors.New("the parameter speechRegion is defined as")
 	}
 	if g.speechKey == "" {
 		return nil, errors.New("the parameter speechKey is defined as")
 	}
 
+	g.setEndpoints()
+	g.setHTTPClient()
+
 	return g, nil
 }
 
@@ -59,51 +90,21 @@ func WithSpeechRegion(speechRegion string) Option {
 	}
 }
 
-func (g *GoTTS) setToken() error {
-	if g.token != "" {
-		return nil
-	}
-
-	uri := fmt.Sprintf(apiToken, g.speechRegion)
-
-	header := map[string]any{
-		"Ocp-Apim-Subscription-Key": g.speechKey,
-	}
-
-	client := internal.NewHTTPClient(
-		g.ctx,
-		internal.WithHeader(header),
-		internal.WithContentType(internal.HttpFormUrlencoded),
-	)
-	resp, funcClose, err := client.SendRequest(http.MethodPost, uri, nil)
-	defer funcClose()
-	if err != nil {
-		return err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return errors.New("set token function request code:" + resp.Status)
-	}
-
-	req, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return err
-	}
-
-	g.token = string(req)
-
-	return nil
-}
-
 // GetVoiceList 获取语音列表
 func (g *GoTTS) GetVoiceList() (*[]VoiceList, error) {
-	url := fmt.Sprintf(apiVoiceList, g.speechRegion)
+	return g.GetVoiceListContext(g.ctx)
+}
+
+// GetVoiceListContext 获取语音列表，ctx 取消时中断请求
+func (g *GoTTS) GetVoiceListContext(ctx context.Context) (*[]VoiceList, error) {
+	url := g.endpoint + apiVoiceList
 
 	header := map[string]any{
 		"Ocp-Apim-Subscription-Key": g.speechKey,
 	}
-	client := internal.NewHTTPClient(
-		g.ctx,
+	client := g.newHTTPClient(
+		ctx,
+		g.voiceListTimeout,
 		internal.WithHeader(header),
 		internal.WithContentType(internal.HttpFormUrlencoded),
 	)
@@ -114,7 +115,7 @@ func (g *GoTTS) GetVoiceList() (*[]VoiceList, error) {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New(resp.Status)
+		return nil, newAPIError(opGetVoiceList, resp)
 	}
 
 	req, err := io.ReadAll(resp.Body)
@@ -132,61 +133,111 @@ func (g *GoTTS) GetVoiceList() (*[]VoiceList, error) {
 }
 
 // TextToVoiceDisk 文本转语音
-func (g *GoTTS) TextToVoiceDisk(outFormat SsmlOut, ssml *SpeakXml, outFile *os.File) error {
-	resp, funcClose, err := g.TextToVoice(outFormat, ssml)
-	defer funcClose()
-	if err != nil {
-		return err
-	}
+func (g *GoTTS) TextToVoiceDisk(outFormat SsmlOut, ssml SsmlDocument, outFile *os.File) error {
+	return g.TextToVoiceDiskContext(g.ctx, outFormat, ssml, outFile)
+}
 
-	return internal.WriteToDisk(resp.Body, outFile)
+// TextToVoiceDiskContext 文本转语音并写入文件，ctx 取消时中断请求及写入
+func (g *GoTTS) TextToVoiceDiskContext(ctx context.Context, outFormat SsmlOut, ssml SsmlDocument, outFile *os.File) error {
+	_, err := g.TextToVoiceTo(ctx, outFormat, ssml, outFile)
+	return err
 }
 
 // TextToVoice 文本转语音
-func (g *GoTTS) TextToVoice(outFormat SsmlOut, ssml *SpeakXml) (*http.Response, func(), error) {
-	uri := fmt.Sprintf(apiTextToVoice, g.speechRegion)
+func (g *GoTTS) TextToVoice(outFormat SsmlOut, ssml SsmlDocument) (*http.Response, func(), error) {
+	return g.TextToVoiceContext(g.ctx, outFormat, ssml)
+}
 
-	err := g.setToken()
+// TextToVoiceContext 文本转语音，ctx 取消时中断请求及响应体的读取
+func (g *GoTTS) TextToVoiceContext(ctx context.Context, outFormat SsmlOut, ssml SsmlDocument) (*http.Response, func(), error) {
+	xmlData, err := ssml.Ssml()
 	if err != nil {
 		return nil, func() {}, err
 	}
 
-	header := map[string]any{
-		"Ocp-Apim-Subscription-Key": g.speechKey,
-		"X-Microsoft-OutputFormat":  outFormat,
-		"User-Agent":                "DouShen",
-		"Authorization":             "Bearer " + g.token,
+	if g.ssmlValidate != nil {
+		if err := ValidateSSML(xmlData, g.ssmlValidate); err != nil {
+			return nil, func() {}, err
+		}
+	}
+	if err := g.checkDuration(xmlData); err != nil {
+		return nil, func() {}, err
 	}
 
-	body := map[string]any{
-		"xml": ssml,
+	token, err := g.getToken(ctx, "")
+	if err != nil {
+		return nil, func() {}, err
 	}
 
-	client := internal.NewHTTPClient(
-		g.ctx,
-		internal.WithTimeout(time.Second*60),
-		internal.WithHeader(header),
-		internal.WithContentType(internal.HttpSsml),
-	)
-	resp, funcClose, err := client.SendRequest(http.MethodPost, uri, body)
+	resp, funcClose, err := g.textToVoice(ctx, outFormat, xmlData, token)
 	if err != nil {
 		return nil, funcClose, err
 	}
 
+	// 令牌被服务端判定失效时，刷新令牌后重试一次
+	if resp.StatusCode == http.StatusUnauthorized {
+		funcClose()
+		token, err = g.getToken(ctx, token)
+		if err != nil {
+			return nil, func() {}, err
+		}
+		resp, funcClose, err = g.textToVoice(ctx, outFormat, xmlData, token)
+		if err != nil {
+			return nil, funcClose, err
+		}
+	}
+
 	if resp.StatusCode != http.StatusOK {
-		return nil, funcClose, errors.New(resp.Status)
+		return nil, funcClose, newAPIError(opTextToVoice, resp)
 	}
 
 	if resp.ContentLength == 0 {
-		return nil, funcClose, errors.New("http response ContentLength=0")
+		return nil, funcClose, ErrEmptyAudio
 	}
 
 	return resp, funcClose, nil
 }
 
+func (g *GoTTS) textToVoice(ctx context.Context, outFormat SsmlOut, xmlData []byte, token string) (*http.Response, func(), error) {
+	uri := g.endpoint + apiTextToVoice
+
+	header := map[string]any{
+		"Ocp-Apim-Subscription-Key": g.speechKey,
+		"X-Microsoft-OutputFormat":  outFormat,
+		"User-Agent":                "DouShen",
+		"Authorization":             "Bearer " + token,
+	}
+
+	body := map[string]any{
+		"xml": xmlData,
+	}
+
+	// 音频以流的方式读取，超时只限制等待响应头的时间
+	client := g.newHTTPClient(
+		ctx,
+		g.synthesisTimeout,
+		internal.WithHeaderTimeout(g.synthesisTimeout),
+		internal.WithHeader(header),
+		internal.WithContentType(internal.HttpSsml),
+		internal.WithIdempotent(true),
+	)
+	return client.SendRequest(http.MethodPost, uri, body)
+}
+
 // LongTextToVoiceCreate 创建批处理合成（长语音）
 func (g *GoTTS) LongTextToVoiceCreate(longSpeak *LongSpeak) (*LongTextToVoiceCreateRep, error) {
-	uri := fmt.Sprintf(apiLongTextToVoice, g.speechRegion)
+	return g.LongTextToVoiceCreateContext(g.ctx, longSpeak)
+}
+
+// LongTextToVoiceCreateContext 创建批处理合成（长语音），ctx 取消时中断请求
+func (g *GoTTS) LongTextToVoiceCreateContext(ctx context.Context, longSpeak *LongSpeak) (*LongTextToVoiceCreateRep, error) {
+	res, _, err := g.longTextToVoiceCreate(ctx, longSpeak)
+	return res, err
+}
+
+// longTextToVoiceCreate 创建批处理合成，同时返回响应头
+func (g *GoTTS) longTextToVoiceCreate(ctx context.Context, longSpeak *LongSpeak) (*LongTextToVoiceCreateRep, http.Header, error) {
+	uri := g.batchEndpoint + apiLongTextToVoice
 	jsonData, _ := json.Marshal(longSpeak)
 	header := map[string]any{
 		"Ocp-Apim-Subscription-Key": g.speechKey,
@@ -195,84 +246,101 @@ func (g *GoTTS) LongTextToVoiceCreate(longSpeak *LongSpeak) (*LongTextToVoiceCre
 		"json": string(jsonData),
 	}
 
-	client := internal.NewHTTPClient(
-		g.ctx,
+	client := g.newHTTPClient(
+		ctx,
+		g.batchTimeout,
 		internal.WithHeader(header),
 		internal.WithContentType(internal.HttpJson),
 	)
 	resp, funcClose, err := client.SendRequest(http.MethodPost, uri, body)
 	defer funcClose()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// 201 是成功，其他都是失败
 	if resp.StatusCode != http.StatusCreated {
-		return nil, errors.New(resp.Status)
+		return nil, nil, newAPIError(opLongTextToVoiceCreate, resp)
 	}
 
 	req, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	res := &LongTextToVoiceCreateRep{}
 	err = json.Unmarshal(req, res)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return res, nil
+	return res, resp.Header, nil
 }
 
 // LongTextToVoiceId 获取批处理合成（长语音）
 func (g *GoTTS) LongTextToVoiceId(id string) (*LongTextToVoiceGetIdRep, error) {
-	uri := fmt.Sprintf(apiLongTextToVoice, g.speechRegion) + "/" + id
+	return g.LongTextToVoiceIdContext(g.ctx, id)
+}
+
+// LongTextToVoiceIdContext 获取批处理合成（长语音），ctx 取消时中断请求
+func (g *GoTTS) LongTextToVoiceIdContext(ctx context.Context, id string) (*LongTextToVoiceGetIdRep, error) {
+	res, _, err := g.longTextToVoiceId(ctx, g.batchEndpoint+apiLongTextToVoice+"/"+id)
+	return res, err
+}
 
+// longTextToVoiceId 查询 uri 对应的批处理合成，同时返回响应头
+func (g *GoTTS) longTextToVoiceId(ctx context.Context, uri string) (*LongTextToVoiceGetIdRep, http.Header, error) {
 	header := map[string]any{
 		"Ocp-Apim-Subscription-Key": g.speechKey,
 	}
 
-	client := internal.NewHTTPClient(
-		g.ctx,
+	client := g.newHTTPClient(
+		ctx,
+		g.batchTimeout,
 		internal.WithHeader(header),
 		internal.WithContentType(internal.HttpJson),
 	)
 	resp, funcClose, err := client.SendRequest(http.MethodGet, uri, nil)
 	defer funcClose()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New(resp.Status)
+		return nil, nil, newAPIError(opLongTextToVoiceId, resp)
 	}
 
 	req, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	res := &LongTextToVoiceGetIdRep{}
 	err = jsonUtil.JsonToStruct(string(req), res)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return res, nil
+	return res, resp.Header, nil
 }
 
 // LongTextToVoice 列出批处理合成（长语音）
 func (g *GoTTS) LongTextToVoice(skip, top string) (*LongTextToVoiceGetRep, error) {
+	return g.LongTextToVoiceContext(g.ctx, skip, top)
+}
+
+// LongTextToVoiceContext 列出批处理合成（长语音），ctx 取消时中断请求
+func (g *GoTTS) LongTextToVoiceContext(ctx context.Context, skip, top string) (*LongTextToVoiceGetRep, error) {
 	params := fmt.Sprintf("?skip=%s&top=%s", skip, top)
-	uri := fmt.Sprintf(apiLongTextToVoice, g.speechRegion) + params
+	uri := g.batchEndpoint + apiLongTextToVoice + params
 
 	header := map[string]any{
 		"Ocp-Apim-Subscription-Key": g.speechKey,
 	}
 
-	client := internal.NewHTTPClient(
-		g.ctx,
+	client := g.newHTTPClient(
+		ctx,
+		g.batchTimeout,
 		internal.WithHeader(header),
 		internal.WithContentType(internal.HttpJson),
 	)
@@ -283,7 +351,7 @@ func (g *GoTTS) LongTextToVoice(skip, top string) (*LongTextToVoiceGetRep, error
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New(resp.Status)
+		return nil, newAPIError(opLongTextToVoice, resp)
 	}
 
 	req, err := io.ReadAll(resp.Body)
@@ -302,23 +370,32 @@ func (g *GoTTS) LongTextToVoice(skip, top string) (*LongTextToVoiceGetRep, error
 
 // LongTextToVoiceDel 删除批处理合成（长语音）
 func (g *GoTTS) LongTextToVoiceDel(id string) (bool, error) {
-	uri := fmt.Sprintf(apiLongTextToVoice, g.speechRegion) + "/" + id
+	return g.LongTextToVoiceDelContext(g.ctx, id)
+}
+
+// LongTextToVoiceDelContext 删除批处理合成（长语音），ctx 取消时中断请求
+func (g *GoTTS) LongTextToVoiceDelContext(ctx context.Context, id string) (bool, error) {
+	uri := g.batchEndpoint + apiLongTextToVoice + "/" + id
 
 	header := map[string]any{
 		"Ocp-Apim-Subscription-Key": g.speechKey,
 	}
 
-	client := internal.NewHTTPClient(
-		g.ctx,
+	client := g.newHTTPClient(
+		ctx,
+		g.batchTimeout,
 		internal.WithHeader(header),
 		internal.WithContentType(internal.HttpJson),
 	)
 	resp, funcClose, err := client.SendRequest(http.MethodDelete, uri, nil)
 	defer funcClose()
+	if err != nil {
+		return false, err
+	}
 
-	if resp.StatusCode == http.StatusNoContent {
-		return true, nil
+	if resp.StatusCode != http.StatusNoContent {
+		return false, newAPIError(opLongTextToVoiceDel, resp)
 	}
 
-	return false, err
+	return true, nil
 }
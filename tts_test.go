@@ -5,15 +5,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/xuemingjings/go-micro-tts/ttstest"
 	"io"
-	"log"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
-	"time"
 )
 
 var (
+	// 真实服务的配置，为空时跳过访问真实服务的测试
 	speechKey    = ""
 	speechRegion = ""
 
@@ -37,50 +39,64 @@ var (
 	`
 )
 
-func TestGetToken(t *testing.T) {
-	ctx := context.TODO()
+// newTestTTS 创建连接到模拟服务的 GoTTS
+func newTestTTS(t *testing.T, opts ...ttstest.Option) (*GoTTS, *ttstest.Server) {
+	t.Helper()
+
+	srv := ttstest.NewServer(opts...)
+	t.Cleanup(srv.Close)
+
 	tts, err := NewGoTTS(
-		ctx,
-		WithSpeechRegion(speechRegion),
-		WithSpeechKey(speechKey),
+		context.TODO(),
+		WithSpeechKey(srv.SpeechKey),
+		WithEndpoint(srv.URL),
+		WithTokenEndpoint(srv.URL),
+		WithBatchEndpoint(srv.URL),
+		WithHTTPClient(srv.Client()),
 	)
 	if err != nil {
-		log.Fatalf("初始化报错 err:%v", err)
+		t.Fatalf("初始化报错 err:%v", err)
 	}
 
-	token := tts.token
-	log.Printf("得到的token: %v", token)
+	return tts, srv
 }
 
-func TestGetVoiceList(t *testing.T) {
-	ctx := context.TODO()
-	tts, err := NewGoTTS(
-		ctx,
-		WithSpeechRegion(speechRegion),
-		WithSpeechKey(speechKey),
-	)
+func TestGetToken(t *testing.T) {
+	tts, _ := newTestTTS(t)
+
+	token, err := tts.getToken(context.TODO(), "")
 	if err != nil {
-		log.Fatalf("初始化报错 err:%v", err)
+		t.Fatalf("获取token报错 err:%v", err)
+	}
+	if token == "" || token != tts.token {
+		t.Fatalf("得到的token: %v, 缓存的token: %v", token, tts.token)
 	}
+}
+
+func TestGetTokenInvalidKey(t *testing.T) {
+	tts, _ := newTestTTS(t, ttstest.WithSpeechKey("other-key"))
+	tts.speechKey = "wrong-key"
+
+	if _, err := tts.getToken(context.TODO(), ""); err == nil {
+		t.Fatal("错误的speechKey应返回错误")
+	}
+}
+
+func TestGetVoiceList(t *testing.T) {
+	tts, _ := newTestTTS(t)
 
 	list, err := tts.GetVoiceList()
 	if err != nil {
-		log.Fatalf("获取VoiceList报错 err:%v", err)
+		t.Fatalf("获取VoiceList报错 err:%v", err)
 	}
 
-	log.Printf("获取语音列表 %+v", list)
+	if len(*list) != 3 || (*list)[0].ShortName != "zh-CN-YunxiNeural" {
+		t.Errorf("获取语音列表 %+v", list)
+	}
 }
 
 func TestTextToVoiceDisk(t *testing.T) {
-	ctx := context.TODO()
-	tts, err := NewGoTTS(
-		ctx,
-		WithSpeechRegion(speechRegion),
-		WithSpeechKey(speechKey),
-	)
-	if err != nil {
-		log.Fatalf("初始化报错 err:%v", err)
-	}
+	tts, _ := newTestTTS(t)
 
 	outFormat := Audio48kHz96KbitrateMonoMp3
 
@@ -92,34 +108,29 @@ func TestTextToVoiceDisk(t *testing.T) {
 	})
 
 	// 创建输出文件
-	fileName := time.Now().Format("2006-01-02-15-04-05") + "_file.mp3"
+	fileName := filepath.Join(t.TempDir(), "file.mp3")
 	outFile, err := os.Create(fileName)
 	if err != nil {
-		fmt.Println("Error creating output file:", err)
-		return
+		t.Fatalf("Error creating output file: %v", err)
 	}
 	defer outFile.Close()
 
 	err = tts.TextToVoiceDisk(outFormat, ssml, outFile)
-
 	if err != nil {
-		log.Printf("TextToVoice调用发生了错误 err:%v \n", err)
-		return
+		t.Fatalf("TextToVoice调用发生了错误 err:%v", err)
 	}
 
-	log.Println("文本转语音文件已生成： " + fileName)
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(data), string(outFormat)+"\n") || !strings.Contains(string(data), "中华兴盛 幸有斌哥 how are you") {
+		t.Errorf("文件内容 = %s", data)
+	}
 }
 
 func TestLongTextToVoiceCreate(t *testing.T) {
-	ctx := context.TODO()
-	tts, err := NewGoTTS(
-		ctx,
-		WithSpeechRegion(speechRegion),
-		WithSpeechKey(speechKey),
-	)
-	if err != nil {
-		log.Fatalf("初始化报错 err:%v", err)
-	}
+	tts, _ := newTestTTS(t)
 
 	inputs := []*LongSpeakInputs{
 		{
@@ -140,22 +151,16 @@ func TestLongTextToVoiceCreate(t *testing.T) {
 
 	res, err := tts.LongTextToVoiceCreate(req)
 	if err != nil {
-		log.Fatalf("方法返回错误 err:%v", err)
+		t.Fatalf("方法返回错误 err:%v", err)
 	}
 
-	log.Printf("id:%s, res:%+v", res.Id, res)
+	if res.Id == "" || res.Status != "NotStarted" || res.DisplayName != "长文本输出单个音频文件01" {
+		t.Errorf("id:%s, res:%+v", res.Id, res)
+	}
 }
 
 func TestLongTextToVoiceCreate2(t *testing.T) {
-	ctx := context.TODO()
-	tts, err := NewGoTTS(
-		ctx,
-		WithSpeechRegion(speechRegion),
-		WithSpeechKey(speechKey),
-	)
-	if err != nil {
-		log.Fatalf("初始化报错 err:%v", err)
-	}
+	tts, _ := newTestTTS(t)
 
 	inputs := []*LongSpeakInputs{
 		{
@@ -176,22 +181,16 @@ func TestLongTextToVoiceCreate2(t *testing.T) {
 
 	res, err := tts.LongTextToVoiceCreate(req)
 	if err != nil {
-		log.Fatalf("方法返回错误 err:%v", err)
+		t.Fatalf("方法返回错误 err:%v", err)
 	}
 
-	log.Printf("id:%s, res:%+v", res.Id, res)
+	if res.Id == "" {
+		t.Errorf("id:%s, res:%+v", res.Id, res)
+	}
 }
 
 func TestLongTextToVoiceCreate3(t *testing.T) {
-	ctx := context.TODO()
-	tts, err := NewGoTTS(
-		ctx,
-		WithSpeechRegion(speechRegion),
-		WithSpeechKey(speechKey),
-	)
-	if err != nil {
-		log.Fatalf("初始化报错 err:%v", err)
-	}
+	tts, srv := newTestTTS(t)
 
 	inputs := []*LongSpeakInputs{
 		{
@@ -218,77 +217,109 @@ func TestLongTextToVoiceCreate3(t *testing.T) {
 
 	res, err := tts.LongTextToVoiceCreate(req)
 	if err != nil {
-		log.Fatalf("方法返回错误 err:%v", err)
+		t.Fatalf("方法返回错误 err:%v", err)
 	}
 
-	log.Printf("id:%s, res:%+v", res.Id, res)
+	// 模拟服务依次返回 NotStarted、Running、Succeeded
+	var got *LongTextToVoiceGetIdRep
+	for i := 0; i < 3; i++ {
+		got, err = tts.LongTextToVoiceId(res.Id)
+		if err != nil {
+			t.Fatalf("方法返回错误 err:%v", err)
+		}
+	}
+	if got.Status != "Succeeded" || got.Properties.SucceededAudioCount != 3 {
+		t.Errorf("方法返回 res: %+v", got)
+	}
+	if got.Outputs.Result != srv.URL+ttstest.PathResults+res.Id+".zip?sig=ttstest" {
+		t.Errorf("下载的文件 %s", got.Outputs.Result)
+	}
 }
 
 func TestLongTextToVoiceId(t *testing.T) {
-	ctx := context.TODO()
-	tts, err := NewGoTTS(
-		ctx,
-		WithSpeechRegion(speechRegion),
-		WithSpeechKey(speechKey),
-	)
+	tts, _ := newTestTTS(t)
+
+	_, err := tts.LongTextToVoiceId("df052889-caa3-4e70-ba2a-a0d3d9abd8eb")
+	if err == nil {
+		t.Fatal("不存在的任务应返回错误")
+	}
+
+	created, err := tts.LongTextToVoiceCreate(NewLongSpeak(&LongSpeakXmlReq{
+		DisplayName:          "获取任务",
+		Inputs:               []*LongSpeakInputs{{Text: "中华兴盛，幸有斌哥"}},
+		OutputFormat:         Audio48kHz96KbitrateMonoMp3,
+		SynthesisConfigVoice: "zh-CN-YunxiNeural",
+	}))
 	if err != nil {
-		log.Fatalf("初始化报错 err:%v", err)
+		t.Fatalf("方法返回错误 err:%v", err)
 	}
 
-	res, err := tts.LongTextToVoiceId("df052889-caa3-4e70-ba2a-a0d3d9abd8eb")
+	res, err := tts.LongTextToVoiceId(created.Id)
 	if err != nil {
-		log.Fatalf("方法返回错误 err:%v", err)
+		t.Fatalf("方法返回错误 err:%v", err)
 	}
 
 	jsonByte, _ := json.Marshal(res)
-
-	log.Println("下载的文件", res.Outputs.Result)
-	log.Printf("方法返回 res: %v", string(jsonByte))
+	if res.Id != created.Id || res.SynthesisConfig.Voice != "zh-CN-YunxiNeural" {
+		t.Errorf("方法返回 res: %v", string(jsonByte))
+	}
 }
 
 func TestLongTextToVoice(t *testing.T) {
-	ctx := context.TODO()
-	tts, err := NewGoTTS(
-		ctx,
-		WithSpeechRegion(speechRegion),
-		WithSpeechKey(speechKey),
-	)
-	if err != nil {
-		log.Fatalf("初始化报错 err:%v", err)
+	tts, _ := newTestTTS(t)
+
+	for i := 0; i < 3; i++ {
+		_, err := tts.LongTextToVoiceCreate(NewLongSpeak(&LongSpeakXmlReq{
+			DisplayName:  fmt.Sprintf("任务%d", i),
+			Inputs:       []*LongSpeakInputs{{Text: "中华兴盛，幸有斌哥"}},
+			OutputFormat: Audio48kHz96KbitrateMonoMp3,
+		}))
+		if err != nil {
+			t.Fatalf("方法返回错误 err:%v", err)
+		}
 	}
 
-	res, err := tts.LongTextToVoice("0", "100")
+	res, err := tts.LongTextToVoice("1", "100")
 	if err != nil {
-		log.Fatalf("方法返回错误 err:%v", err)
+		t.Fatalf("方法返回错误 err:%v", err)
 	}
 
 	jsonByte, _ := json.Marshal(res)
-
-	log.Printf("列表方法返回 res: %v", string(jsonByte))
+	if len(res.Values) != 2 || res.Values[0].DisplayName != "任务1" {
+		t.Errorf("列表方法返回 res: %v", string(jsonByte))
+	}
 }
 
 func TestLongTextToVoiceDel(t *testing.T) {
-	ctx := context.TODO()
-	tts, err := NewGoTTS(
-		ctx,
-		WithSpeechRegion(speechRegion),
-		WithSpeechKey(speechKey),
-	)
+	tts, _ := newTestTTS(t)
+
+	created, err := tts.LongTextToVoiceCreate(NewLongSpeak(&LongSpeakXmlReq{
+		DisplayName:  "删除任务",
+		Inputs:       []*LongSpeakInputs{{Text: "中华兴盛，幸有斌哥"}},
+		OutputFormat: Audio48kHz96KbitrateMonoMp3,
+	}))
 	if err != nil {
-		log.Fatalf("初始化报错 err:%v", err)
+		t.Fatalf("方法返回错误 err:%v", err)
 	}
 
-	res, err := tts.LongTextToVoiceDel("67d8f902-1daa-4971-9292-76692ad96556")
-	if err != nil {
-		log.Fatalf("方法返回错误 err:%v", err)
+	res, err := tts.LongTextToVoiceDel(created.Id)
+	if err != nil || !res {
+		t.Fatalf("res:%v, err:%v", res, err)
 	}
 
-	log.Printf("res:%v", res)
+	res, _ = tts.LongTextToVoiceDel(created.Id)
+	if res {
+		t.Errorf("重复删除 res:%v", res)
+	}
 }
 
 // 创建批处理合成
 // https://learn.microsoft.com/zh-cn/azure/ai-services/speech-service/batch-synthesis#create-batch-synthesis
 func TestLongBatchSynthesisPost(t *testing.T) {
+	if speechKey == "" {
+		t.Skip("未配置 speechKey，跳过访问真实服务的测试")
+	}
+
 	//ctx := context.TODO()
 	//tts, err := NewGoTTS(
 	//	ctx,
@@ -352,6 +383,10 @@ func TestLongBatchSynthesisPost(t *testing.T) {
 
 // 列出批处理合成
 func TestLongBatchSynthesisGet(t *testing.T) {
+	if speechKey == "" {
+		t.Skip("未配置 speechKey，跳过访问真实服务的测试")
+	}
+
 	// 列出批处理合成
 	//uri := "https://%s.customvoice.api.speech.microsoft.com/api/texttospeech/3.1-preview1/batchsynthesis?skip=0&top=100"
 
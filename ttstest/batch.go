@@ -0,0 +1,377 @@
+package ttstest
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const ticksPerMillisecond = 10000
+
+// batchJob 模拟的批处理合成任务
+type batchJob struct {
+	Id              string         `json:"id"`
+	DisplayName     string         `json:"displayName"`
+	Description     string         `json:"description"`
+	TextType        string         `json:"textType"`
+	SynthesisConfig map[string]any `json:"synthesisConfig,omitempty"`
+	CustomVoices    map[string]any `json:"customVoices"`
+	Properties      map[string]any `json:"properties"`
+	Outputs         map[string]any `json:"outputs,omitempty"`
+	Status          string         `json:"status"`
+	CreatedDateTime time.Time      `json:"createdDateTime"`
+	LastActionTime  time.Time      `json:"lastActionDateTime"`
+
+	inputs []string
+	step   int
+	result []byte            // 结果压缩包
+	files  map[string][]byte // 结果文件，decompressOutputFiles 为 true 时按文件名单独下载
+}
+
+type batchRequest struct {
+	DisplayName     string         `json:"displayName"`
+	Description     string         `json:"description"`
+	TextType        string         `json:"textType"`
+	SynthesisConfig map[string]any `json:"synthesisConfig"`
+	Properties      map[string]any `json:"properties"`
+	Inputs          []struct {
+		Text string `json:"text"`
+	} `json:"inputs"`
+}
+
+func (s *Server) handleBatch(w http.ResponseWriter, r *http.Request) {
+	if !s.checkKey(w, r) {
+		return
+	}
+
+	id := strings.Trim(strings.TrimPrefix(r.URL.Path, PathBatch), "/")
+
+	switch {
+	case r.Method == http.MethodPost && id == "":
+		s.createJob(w, r)
+	case r.Method == http.MethodGet && id == "":
+		s.listJobs(w, r)
+	case r.Method == http.MethodGet:
+		s.getJob(w, r, id)
+	case r.Method == http.MethodDelete:
+		s.deleteJob(w, r, id)
+	default:
+		s.writeError(w, r, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method)
+	}
+}
+
+func (s *Server) createJob(w http.ResponseWriter, r *http.Request) {
+	req := &batchRequest{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		s.writeError(w, r, http.StatusBadRequest, "InvalidPayload", err.Error())
+		return
+	}
+	if len(req.Inputs) == 0 {
+		s.writeError(w, r, http.StatusBadRequest, "InvalidPayload", "The inputs are empty.")
+		return
+	}
+	if format, _ := req.Properties["outputFormat"].(string); format == "" {
+		s.writeError(w, r, http.StatusBadRequest, "InvalidPayload", "The output format is required.")
+		return
+	}
+
+	s.mu.Lock()
+	s.jobSeq++
+	now := time.Now().UTC()
+	job := &batchJob{
+		Id:              fmt.Sprintf("00000000-0000-0000-0000-%012d", s.jobSeq),
+		DisplayName:     req.DisplayName,
+		Description:     req.Description,
+		TextType:        req.TextType,
+		SynthesisConfig: req.SynthesisConfig,
+		CustomVoices:    map[string]any{},
+		Properties:      req.Properties,
+		Status:          "NotStarted",
+		CreatedDateTime: now,
+		LastActionTime:  now,
+	}
+	job.Properties["timeToLive"] = "P31D"
+	for _, v := range req.Inputs {
+		job.inputs = append(job.inputs, v.Text)
+	}
+	s.jobs[job.Id] = job
+	s.jobOrder = append(s.jobOrder, job.Id)
+	body, _ := json.Marshal(job)
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Operation-Location", s.URL+PathBatch+"/"+job.Id)
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write(body)
+}
+
+func (s *Server) listJobs(w http.ResponseWriter, r *http.Request) {
+	skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+	top, err := strconv.Atoi(r.URL.Query().Get("top"))
+	if err != nil || top <= 0 {
+		top = 100
+	}
+
+	s.mu.Lock()
+	values := []*batchJob{}
+	for i := skip; i < len(s.jobOrder) && len(values) < top; i++ {
+		values = append(values, s.jobs[s.jobOrder[i]])
+	}
+	body, _ := json.Marshal(map[string]any{"values": values})
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_, _ = w.Write(body)
+}
+
+// getJob 每次查询都将任务推进到下一个状态
+func (s *Server) getJob(w http.ResponseWriter, r *http.Request, id string) {
+	s.mu.Lock()
+	job, ok := s.jobs[id]
+	if !ok {
+		s.mu.Unlock()
+		s.writeError(w, r, http.StatusNotFound, "NotFound", "The batch synthesis "+id+" was not found.")
+		return
+	}
+
+	if job.step < len(s.batchSteps) {
+		job.Status = s.batchSteps[job.step]
+		job.step++
+		job.LastActionTime = time.Now().UTC()
+		if job.step == len(s.batchSteps) {
+			s.finishJob(job)
+		}
+	}
+	body, _ := json.Marshal(job)
+	running := job.Status == "NotStarted" || job.Status == "Running"
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if running && s.retryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(s.retryAfter.Seconds())))
+	}
+	_, _ = w.Write(body)
+}
+
+func (s *Server) deleteJob(w http.ResponseWriter, r *http.Request, id string) {
+	s.mu.Lock()
+	_, ok := s.jobs[id]
+	if ok {
+		delete(s.jobs, id)
+		for i, v := range s.jobOrder {
+			if v == id {
+				s.jobOrder = append(s.jobOrder[:i], s.jobOrder[i+1:]...)
+				break
+			}
+		}
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		s.writeError(w, r, http.StatusNotFound, "NotFound", "The batch synthesis "+id+" was not found.")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleResults 下载结果，/results/{id}.zip 为压缩包，/results/{id}/{name} 为解压后的单个文件
+// 与 Azure Blob 的 SAS 地址一样，缺少 sig 参数时返回 403
+func (s *Server) handleResults(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("sig") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, PathResults)
+	id, name, _ := strings.Cut(path, "/")
+
+	s.mu.Lock()
+	job, ok := s.jobs[strings.TrimSuffix(id, ".zip")]
+	var result []byte
+	if ok && name == "" && strings.HasSuffix(id, ".zip") {
+		result = job.result
+	} else if ok && name != "" {
+		result = job.files[name]
+	}
+	s.mu.Unlock()
+
+	if result == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if name == "" {
+		w.Header().Set("Content-Type", "application/zip")
+	} else {
+		w.Header().Set("Content-Type", "application/octet-stream")
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(result)))
+	_, _ = w.Write(result)
+}
+
+// finishJob 任务进入最终状态，成功时生成结果压缩包
+func (s *Server) finishJob(job *batchJob) {
+	if job.Status != "Succeeded" || s.batchError != nil {
+		if s.batchError != nil {
+			job.Status = "Failed"
+			job.Properties["error"] = s.batchError
+		}
+		return
+	}
+
+	format, _ := job.Properties["outputFormat"].(string)
+	wordEnabled, _ := job.Properties["wordBoundaryEnabled"].(bool)
+	sentenceEnabled, _ := job.Properties["sentenceBoundaryEnabled"].(bool)
+	concatenate, _ := job.Properties["concatenateResult"].(bool)
+	decompress, _ := job.Properties["decompressOutputFiles"].(bool)
+
+	inputs := job.inputs
+	if concatenate {
+		inputs = []string{strings.Join(job.inputs, "")}
+	}
+
+	job.files = map[string][]byte{}
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	writeFile := func(name string, data []byte) {
+		job.files[name] = data
+		writeZipFile(zw, name, data)
+	}
+	writeJson := func(name string, v any) {
+		data, _ := json.MarshalIndent(v, "", "  ")
+		writeFile(name, data)
+	}
+
+	results := []map[string]any{}
+	var audioSize, durationInTicks int64
+	for i, text := range inputs {
+		name := fmt.Sprintf("%04d", i+1)
+		audio := s.audio(format, []byte(text))
+		words, sentences := boundaries(plainText(text))
+		ticks := int64(0)
+		if len(words) > 0 {
+			last := words[len(words)-1]
+			ticks = last.AudioOffset + last.Duration
+		}
+
+		properties := map[string]any{
+			"audioSize":       len(audio),
+			"durationInTicks": ticks,
+			"duration":        fmt.Sprintf("PT%gS", float64(ticks)/1e7),
+		}
+		writeFile(name+extension(format), audio)
+		if wordEnabled {
+			writeJson(name+".word.json", words)
+			properties["wordBoundaryFileName"] = name + ".word.json"
+		}
+		if sentenceEnabled {
+			writeJson(name+".sentence.json", sentences)
+			properties["sentenceBoundaryFileName"] = name + ".sentence.json"
+		}
+
+		results = append(results, map[string]any{
+			"texts":         []string{text},
+			"status":        "Succeeded",
+			"audioFileName": name + extension(format),
+			"properties":    properties,
+		})
+		audioSize += int64(len(audio))
+		durationInTicks += ticks
+	}
+	writeJson("summary.json", map[string]any{
+		"jobID":   job.Id,
+		"status":  "Succeeded",
+		"results": results,
+	})
+	_ = zw.Close()
+
+	job.result = buf.Bytes()
+	if decompress {
+		job.Outputs = map[string]any{"result": s.URL + PathResults + job.Id + "?sig=ttstest"}
+	} else {
+		job.Outputs = map[string]any{"result": s.URL + PathResults + job.Id + ".zip?sig=ttstest"}
+	}
+	job.Properties["audioSize"] = audioSize
+	job.Properties["durationInTicks"] = durationInTicks
+	job.Properties["duration"] = fmt.Sprintf("PT%gS", float64(durationInTicks)/1e7)
+	job.Properties["succeededAudioCount"] = len(inputs)
+	job.Properties["billingDetails"] = map[string]any{"neural": len([]rune(strings.Join(job.inputs, "")))}
+}
+
+func writeZipFile(zw *zip.Writer, name string, data []byte) {
+	f, err := zw.Create(name)
+	if err != nil {
+		return
+	}
+	_, _ = f.Write(data)
+}
+
+// boundary 批处理结果中的字边界及句子边界数据
+type boundary struct {
+	Text        string `json:"Text"`
+	AudioOffset int64  `json:"AudioOffset"`
+	Duration    int64  `json:"Duration"`
+}
+
+var tagRegexp = regexp.MustCompile(`<[^>]*>`)
+
+func plainText(text string) string {
+	return strings.TrimSpace(tagRegexp.ReplaceAllString(text, " "))
+}
+
+// boundaries 按单词（汉字按单字）生成字边界，每个字 250ms、间隔 50ms，并按句末标点生成句子边界
+func boundaries(text string) (words, sentences []boundary) {
+	const (
+		wordTicks = 250 * ticksPerMillisecond
+		gapTicks  = 50 * ticksPerMillisecond
+	)
+
+	var word []rune
+	offset := int64(0)
+	sentenceStart := int64(0)
+	var sentence []rune
+
+	flushWord := func() {
+		if len(word) == 0 {
+			return
+		}
+		words = append(words, boundary{Text: string(word), AudioOffset: offset, Duration: wordTicks})
+		offset += wordTicks + gapTicks
+		word = word[:0]
+	}
+	flushSentence := func() {
+		flushWord()
+		text := strings.TrimSpace(string(sentence))
+		if text != "" && offset > sentenceStart {
+			sentences = append(sentences, boundary{Text: text, AudioOffset: sentenceStart, Duration: offset - gapTicks - sentenceStart})
+		}
+		sentence = sentence[:0]
+		sentenceStart = offset
+	}
+
+	for _, r := range text {
+		sentence = append(sentence, r)
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flushWord()
+			word = append(word, r)
+			flushWord()
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '\'':
+			word = append(word, r)
+		case strings.ContainsRune(".!?。！？", r):
+			flushSentence()
+		default:
+			flushWord()
+		}
+	}
+	flushSentence()
+
+	return words, sentences
+}
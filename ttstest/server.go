@@ -0,0 +1,455 @@
+// Package ttstest 提供基于 httptest 的微软语音服务模拟实现，用于离线单元测试
+package ttstest
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/xuemingjings/go-micro-tts/audio"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 模拟服务实现的接口路径
+const (
+	PathToken     = "/sts/v1.0/issueToken"
+	PathVoiceList = "/cognitiveservices/voices/list"
+	PathSynthesis = "/cognitiveservices/v1"
+	PathBatch     = "/api/texttospeech/3.1-preview1/batchsynthesis"
+	PathResults   = "/results/"
+	PathWebSocket = "/cognitiveservices/websocket/v1"
+)
+
+// DefaultSpeechKey 模拟服务默认接受的 SPEECH_KEY
+const DefaultSpeechKey = "ttstest-key"
+
+// DefaultVoiceList 模拟服务默认返回的语音列表
+var DefaultVoiceList = []byte(`[
+    {
+        "Name": "Microsoft Server Speech Text to Speech Voice (zh-CN, YunxiNeural)",
+        "DisplayName": "Yunxi",
+        "LocalName": "云希",
+        "ShortName": "zh-CN-YunxiNeural",
+        "Gender": "Male",
+        "Locale": "zh-CN",
+        "LocaleName": "Chinese (Mandarin, Simplified)",
+        "StyleList": ["narration-relaxed", "embarrassed", "fearful", "cheerful", "disgruntled", "serious", "angry", "sad", "depressed", "chat", "assistant", "newscast"],
+        "SampleRateHertz": "48000",
+        "VoiceType": "Neural",
+        "Status": "GA",
+        "RolePlayList": ["Narrator", "YoungAdultMale", "Boy"],
+        "WordsPerMinute": "293"
+    },
+    {
+        "Name": "Microsoft Server Speech Text to Speech Voice (en-US, JennyNeural)",
+        "DisplayName": "Jenny",
+        "LocalName": "Jenny",
+        "ShortName": "en-US-JennyNeural",
+        "Gender": "Female",
+        "Locale": "en-US",
+        "LocaleName": "English (United States)",
+        "StyleList": ["assistant", "chat", "customerservice", "newscast", "angry", "cheerful", "sad", "excited", "friendly", "terrified", "shouting", "unfriendly", "whispering", "hopeful"],
+        "SampleRateHertz": "24000",
+        "VoiceType": "Neural",
+        "Status": "GA",
+        "ExtendedPropertyMap": {"IsHighQuality48K": "True"},
+        "WordsPerMinute": "152"
+    },
+    {
+        "Name": "Microsoft Server Speech Text to Speech Voice (en-US, AvaMultilingualNeural)",
+        "DisplayName": "Ava Multilingual",
+        "LocalName": "Ava Multilingual",
+        "ShortName": "en-US-AvaMultilingualNeural",
+        "Gender": "Female",
+        "Locale": "en-US",
+        "LocaleName": "English (United States)",
+        "SecondaryLocaleList": ["zh-CN", "ja-JP", "fr-FR"],
+        "SampleRateHertz": "24000",
+        "VoiceType": "Neural",
+        "Status": "Preview",
+        "VoiceTag": {"TailoredScenarios": ["Chat", "Assistant"]},
+        "WordsPerMinute": "150"
+    }
+]`)
+
+// Server 模拟微软语音服务
+// 实现了访问令牌、语音列表、短文本合成、WebSocket 实时合成及批处理合成接口，并支持注入限流与错误
+type Server struct {
+	URL       string // 模拟服务地址，可同时作为 WithEndpoint、WithTokenEndpoint、WithBatchEndpoint 的参数
+	SpeechKey string // 模拟服务接受的 SPEECH_KEY
+
+	srv *httptest.Server
+
+	mu          sync.Mutex
+	tokenTTL    time.Duration
+	tokens      map[string]time.Time // 令牌 -> 颁发时间
+	tokenSeq    int
+	voiceList   []byte
+	audio       AudioFunc
+	batchSteps  []string
+	batchError  *ErrorBody
+	jobs        map[string]*batchJob
+	jobOrder    []string
+	jobSeq      int
+	faults      []*fault
+	requests    map[string]int
+	requestSeq  int
+	retryAfter  time.Duration
+	maxSsmlSize int
+}
+
+// AudioFunc 根据输出格式及 SSML 生成模拟的音频数据
+type AudioFunc func(format string, ssml []byte) []byte
+
+// ErrorBody 模拟服务返回的错误信息
+type ErrorBody struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type fault struct {
+	path       string
+	remain     int
+	status     int
+	body       ErrorBody
+	retryAfter time.Duration
+}
+
+type Option func(*Server)
+
+// WithSpeechKey 设置模拟服务接受的 SPEECH_KEY，默认 DefaultSpeechKey
+func WithSpeechKey(key string) Option {
+	return func(s *Server) {
+		s.SpeechKey = key
+	}
+}
+
+// WithTokenTTL 设置访问令牌的有效期，默认 10 分钟
+func WithTokenTTL(ttl time.Duration) Option {
+	return func(s *Server) {
+		s.tokenTTL = ttl
+	}
+}
+
+// WithVoiceList 设置语音列表接口返回的 JSON，默认 DefaultVoiceList
+func WithVoiceList(list []byte) Option {
+	return func(s *Server) {
+		s.voiceList = list
+	}
+}
+
+// WithAudio 设置生成模拟音频的方法，默认返回 "输出格式\nSSML"
+func WithAudio(fn AudioFunc) Option {
+	return func(s *Server) {
+		s.audio = fn
+	}
+}
+
+// WithBatchSteps 设置批处理任务每次被查询时依次返回的状态，默认 NotStarted、Running、Succeeded
+func WithBatchSteps(steps ...string) Option {
+	return func(s *Server) {
+		s.batchSteps = steps
+	}
+}
+
+// WithBatchFailure 批处理任务最终以 Failed 结束并返回该错误
+func WithBatchFailure(code, message string) Option {
+	return func(s *Server) {
+		s.batchError = &ErrorBody{Code: code, Message: message}
+	}
+}
+
+// WithBatchRetryAfter 查询未结束的批处理任务时返回 Retry-After 响应头
+func WithBatchRetryAfter(d time.Duration) Option {
+	return func(s *Server) {
+		s.retryAfter = d
+	}
+}
+
+// WithMaxSsmlSize 短文本合成接口允许的 SSML 最大字节数，超出返回 400
+func WithMaxSsmlSize(size int) Option {
+	return func(s *Server) {
+		s.maxSsmlSize = size
+	}
+}
+
+// NewServer 启动模拟服务，使用完毕后需调用 Close
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		SpeechKey:  DefaultSpeechKey,
+		tokenTTL:   10 * time.Minute,
+		tokens:     map[string]time.Time{},
+		voiceList:  DefaultVoiceList,
+		audio:      defaultAudio,
+		batchSteps: []string{"NotStarted", "Running", "Succeeded"},
+		jobs:       map[string]*batchJob{},
+		requests:   map[string]int{},
+	}
+
+	for _, o := range opts {
+		o(s)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(PathToken, s.handleToken)
+	mux.HandleFunc(PathVoiceList, s.handleVoiceList)
+	mux.HandleFunc(PathSynthesis, s.handleSynthesis)
+	mux.HandleFunc(PathBatch, s.handleBatch)
+	mux.HandleFunc(PathBatch+"/", s.handleBatch)
+	mux.HandleFunc(PathResults, s.handleResults)
+	mux.HandleFunc(PathWebSocket, s.handleWebSocket)
+
+	s.srv = httptest.NewServer(s.middleware(mux))
+	s.URL = s.srv.URL
+
+	return s
+}
+
+// Close 关闭模拟服务
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client 返回可访问模拟服务的 http.Client
+func (s *Server) Client() *http.Client {
+	return s.srv.Client()
+}
+
+// Throttle 之后 n 个路径前缀为 path 的请求返回 429，path 为空时匹配所有请求
+func (s *Server) Throttle(path string, n int, retryAfter time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &fault{
+		path:   path,
+		remain: n,
+		status: http.StatusTooManyRequests,
+		body: ErrorBody{
+			Code:    "TooManyRequests",
+			Message: "Too many requests. Please try again later.",
+		},
+		retryAfter: retryAfter,
+	})
+}
+
+// Fail 之后 n 个路径前缀为 path 的请求返回指定的错误，path 为空时匹配所有请求
+func (s *Server) Fail(path string, n int, status int, code, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &fault{
+		path:   path,
+		remain: n,
+		status: status,
+		body:   ErrorBody{Code: code, Message: message},
+	})
+}
+
+// ExpireTokens 使已颁发的全部访问令牌失效
+func (s *Server) ExpireTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for token := range s.tokens {
+		s.tokens[token] = time.Time{}
+	}
+}
+
+// Requests 返回路径前缀为 path 的请求次数，path 为空时返回全部请求次数
+func (s *Server) Requests(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := 0
+	for p, c := range s.requests {
+		if strings.HasPrefix(p, path) {
+			n += c
+		}
+	}
+	return n
+}
+
+// middleware 记录请求并按顺序消费注入的错误
+func (s *Server) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests[r.URL.Path]++
+		s.requestSeq++
+		w.Header().Set("X-RequestId", fmt.Sprintf("ttstest-%d", s.requestSeq))
+
+		var f *fault
+		for _, v := range s.faults {
+			if v.remain > 0 && strings.HasPrefix(r.URL.Path, v.path) {
+				v.remain--
+				f = v
+				break
+			}
+		}
+		s.mu.Unlock()
+
+		if f != nil {
+			if f.retryAfter > 0 {
+				w.Header().Set("Retry-After", strconv.Itoa(int(f.retryAfter.Seconds())))
+			}
+			s.writeError(w, r, f.status, f.body.Code, f.body.Message)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// writeError 批处理接口的错误信息位于顶层，其他接口位于 error 字段中
+func (s *Server) writeError(w http.ResponseWriter, r *http.Request, status int, code, message string) {
+	body := ErrorBody{Code: code, Message: message}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if strings.HasPrefix(r.URL.Path, PathBatch) {
+		_ = json.NewEncoder(w).Encode(body)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{"error": body})
+}
+
+func (s *Server) checkKey(w http.ResponseWriter, r *http.Request) bool {
+	if r.Header.Get("Ocp-Apim-Subscription-Key") == s.SpeechKey {
+		return true
+	}
+	s.writeError(w, r, http.StatusUnauthorized, "401", "Access denied due to invalid subscription key or wrong API endpoint.")
+	return false
+}
+
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		s.writeError(w, r, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method)
+		return
+	}
+	if !s.checkKey(w, r) {
+		return
+	}
+
+	s.mu.Lock()
+	s.tokenSeq++
+	token := fmt.Sprintf("ttstest-token-%d", s.tokenSeq)
+	s.tokens[token] = time.Now()
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = io.WriteString(w, token)
+}
+
+func (s *Server) handleVoiceList(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		s.writeError(w, r, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method)
+		return
+	}
+	if r.Header.Get("Ocp-Apim-Subscription-Key") != s.SpeechKey && !s.checkToken(r) {
+		s.writeError(w, r, http.StatusUnauthorized, "401", "Access denied due to invalid subscription key or wrong API endpoint.")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_, _ = w.Write(s.voiceList)
+}
+
+// checkToken 校验 Authorization 中的访问令牌是否存在且未过期
+func (s *Server) checkToken(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	issuedAt, ok := s.tokens[token]
+	return ok && time.Since(issuedAt) < s.tokenTTL
+}
+
+func (s *Server) handleSynthesis(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		s.writeError(w, r, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method)
+		return
+	}
+	if !s.checkToken(r) {
+		// 真实服务对过期令牌返回 401 且没有响应体
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	format := r.Header.Get("X-Microsoft-OutputFormat")
+	if format == "" {
+		s.writeError(w, r, http.StatusBadRequest, "BadRequest", "X-Microsoft-OutputFormat header is required.")
+		return
+	}
+	if !strings.HasPrefix(r.Header.Get("Content-Type"), "application/ssml+xml") {
+		s.writeError(w, r, http.StatusUnsupportedMediaType, "UnsupportedMediaType", "Content-Type must be application/ssml+xml.")
+		return
+	}
+
+	ssml, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.writeError(w, r, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+	if s.maxSsmlSize > 0 && len(ssml) > s.maxSsmlSize {
+		s.writeError(w, r, http.StatusBadRequest, "BadRequest", "SSML exceeds the maximum size.")
+		return
+	}
+	if err := wellFormed(ssml); err != nil {
+		s.writeError(w, r, http.StatusBadRequest, "InvalidSsml", err.Error())
+		return
+	}
+
+	audio := s.audio(format, ssml)
+	w.Header().Set("Content-Type", contentType(format))
+	_, _ = w.Write(audio)
+}
+
+// wellFormed 检查 SSML 是否为格式正确的 XML 且根元素为 speak
+func wellFormed(ssml []byte) error {
+	d := xml.NewDecoder(strings.NewReader(string(ssml)))
+	root := ""
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if se, ok := tok.(xml.StartElement); ok && root == "" {
+			root = se.Name.Local
+		}
+	}
+	if root != "speak" {
+		return fmt.Errorf("the root element must be speak, got %q", root)
+	}
+	return nil
+}
+
+func defaultAudio(format string, ssml []byte) []byte {
+	return []byte(format + "\n" + string(ssml))
+}
+
+// contentType 按输出格式返回音频的 Content-Type
+func contentType(format string) string {
+	f, err := audio.ParseFormat(format)
+	if err != nil {
+		return "application/octet-stream"
+	}
+	return f.MimeType()
+}
+
+// extension 按输出格式返回结果文件的扩展名
+func extension(format string) string {
+	f, err := audio.ParseFormat(format)
+	if err != nil {
+		return ".raw"
+	}
+	return f.Extension()
+}
@@ -0,0 +1,226 @@
+package ttstest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"github.com/xuemingjings/go-micro-tts/internal/websocket"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// synthesisContext synthesis.context 消息中模拟服务使用的字段
+type synthesisContext struct {
+	Synthesis struct {
+		Audio struct {
+			MetadataOptions struct {
+				WordBoundaryEnabled     bool `json:"wordBoundaryEnabled"`
+				SentenceBoundaryEnabled bool `json:"sentenceBoundaryEnabled"`
+				VisemeEnabled           bool `json:"visemeEnabled"`
+				BookmarkEnabled         bool `json:"bookmarkEnabled"`
+				SessionEndEnabled       bool `json:"sessionEndEnabled"`
+			} `json:"metadataOptions"`
+			OutputFormat string `json:"outputFormat"`
+		} `json:"audio"`
+	} `json:"synthesis"`
+}
+
+var (
+	bookmarkRegexp         = regexp.MustCompile(`<bookmark\s+mark="([^"]*)"\s*/>`)
+	facialExpressionRegexp = regexp.MustCompile(`<mstts:viseme\s+type="FacialExpression"\s*/>`)
+)
+
+// handleWebSocket 模拟实时合成：依次接收 speech.config、synthesis.context、ssml，
+// 之后按字返回元数据及音频数据块，最后返回 SessionEnd 及 turn.end
+// SSML 格式错误时以 1007 关闭连接
+func (s *Server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Ocp-Apim-Subscription-Key") != s.SpeechKey && !s.checkToken(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	conn, err := websocket.Upgrade(w, r)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	sc := &synthesisContext{}
+	var requestId string
+	var ssml []byte
+	for ssml == nil {
+		typ, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		if typ != websocket.TextMessage {
+			_ = conn.CloseWithReason(websocket.CloseProtocolError, "unexpected binary message")
+			return
+		}
+		header, body := parseTextMessage(data)
+		requestId = header["X-RequestId"]
+		switch header["Path"] {
+		case "synthesis.context":
+			if err := json.Unmarshal(body, sc); err != nil {
+				_ = conn.CloseWithReason(websocket.CloseInvalidPayload, err.Error())
+				return
+			}
+		case "ssml":
+			ssml = body
+		}
+	}
+
+	if err := wellFormed(ssml); err != nil {
+		_ = conn.CloseWithReason(websocket.CloseInvalidPayload, "Invalid SSML: "+err.Error())
+		return
+	}
+	if s.maxSsmlSize > 0 && len(ssml) > s.maxSsmlSize {
+		_ = conn.CloseWithReason(websocket.CloseInvalidPayload, "SSML exceeds the maximum size.")
+		return
+	}
+
+	format := sc.Synthesis.Audio.OutputFormat
+	options := sc.Synthesis.Audio.MetadataOptions
+	audio := s.audio(format, ssml)
+	words, sentences := boundaries(plainText(string(ssml)))
+	facialExpression := facialExpressionRegexp.Match(ssml)
+
+	// 书签位于其后第一个字的开始时间
+	bookmarks := map[int][]string{}
+	for _, m := range bookmarkRegexp.FindAllSubmatchIndex(ssml, -1) {
+		before, _ := boundaries(plainText(string(ssml[:m[0]])))
+		bookmarks[len(before)] = append(bookmarks[len(before)], string(ssml[m[2]:m[3]]))
+	}
+
+	send := func(path string, body any) bool {
+		data, _ := json.Marshal(body)
+		msg := "X-RequestId:" + requestId + "\r\nContent-Type:application/json; charset=utf-8\r\nPath:" + path + "\r\n\r\n" + string(data)
+		return conn.WriteMessage(websocket.TextMessage, []byte(msg)) == nil
+	}
+	metadata := func(typ string, data map[string]any) bool {
+		return send("audio.metadata", map[string]any{"Metadata": []map[string]any{{"Type": typ, "Data": data}}})
+	}
+	sendAudio := func(chunk []byte) bool {
+		header := "X-RequestId:" + requestId + "\r\nContent-Type:" + contentType(format) + "\r\nX-StreamId:1\r\nPath:audio\r\n"
+		msg := binary.BigEndian.AppendUint16(nil, uint16(len(header)))
+		msg = append(append(msg, header...), chunk...)
+		return conn.WriteMessage(websocket.BinaryMessage, msg) == nil
+	}
+
+	if !send("turn.start", map[string]any{"context": map[string]any{"serviceTag": requestId}}) ||
+		!send("response", map[string]any{"context": map[string]any{"serviceTag": requestId}, "audio": map[string]any{"type": "inline", "streamId": "1"}}) {
+		return
+	}
+
+	// 音频按字数平均拆分为多个数据块，与元数据交替发送
+	chunkSize := len(audio)/(len(words)+1) + 1
+	nextChunk := func() []byte {
+		n := min(chunkSize, len(audio))
+		chunk := audio[:n]
+		audio = audio[n:]
+		return chunk
+	}
+
+	sentence := 0
+	end := int64(0)
+	for i, word := range words {
+		for _, mark := range bookmarks[i] {
+			if options.BookmarkEnabled && !metadata("Bookmark", map[string]any{"Offset": word.AudioOffset, "Bookmark": mark}) {
+				return
+			}
+		}
+		if sentence < len(sentences) && sentences[sentence].AudioOffset == word.AudioOffset {
+			b := sentences[sentence]
+			sentence++
+			if options.SentenceBoundaryEnabled && !metadata("SentenceBoundary", map[string]any{
+				"Offset":   b.AudioOffset,
+				"Duration": b.Duration,
+				"text":     map[string]any{"Text": b.Text, "Length": len([]rune(b.Text)), "BoundaryType": "SentenceBoundary"},
+			}) {
+				return
+			}
+		}
+		if options.WordBoundaryEnabled && !metadata("WordBoundary", map[string]any{
+			"Offset":   word.AudioOffset,
+			"Duration": word.Duration,
+			"text":     map[string]any{"Text": word.Text, "Length": len([]rune(word.Text)), "BoundaryType": "WordBoundary"},
+		}) {
+			return
+		}
+		if options.VisemeEnabled {
+			data := map[string]any{"Offset": word.AudioOffset, "VisemeId": visemeId(word.Text)}
+			// 与服务端一致，混合形状事件的 VisemeId 为 0
+			if facialExpression {
+				data["VisemeId"] = 0
+				data["AnimationChunk"] = animationChunk(word.AudioOffset, word.Duration, visemeId(word.Text))
+			}
+			if !metadata("Viseme", data) {
+				return
+			}
+		}
+		if !sendAudio(nextChunk()) {
+			return
+		}
+		end = word.AudioOffset + word.Duration
+	}
+	for _, mark := range bookmarks[len(words)] {
+		if options.BookmarkEnabled && !metadata("Bookmark", map[string]any{"Offset": end, "Bookmark": mark}) {
+			return
+		}
+	}
+	for len(audio) > 0 {
+		if !sendAudio(nextChunk()) {
+			return
+		}
+	}
+	if options.SessionEndEnabled && !metadata("SessionEnd", map[string]any{"Offset": end}) {
+		return
+	}
+	if !send("turn.end", map[string]any{}) {
+		return
+	}
+
+	// 等待客户端关闭连接
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+// visemeId 由文本生成 0~21 的模拟口型 ID
+func visemeId(text string) int {
+	id := 0
+	for _, r := range text {
+		id += int(r)
+	}
+	return id % 22
+}
+
+// animationChunk 生成覆盖 offset~offset+duration 的 60 帧/秒模拟混合形状动画，每帧 55 个值
+func animationChunk(offset, duration int64, id int) string {
+	const ticksPerFrame = 10_000_000 / 60
+	first := offset / ticksPerFrame
+	frames := make([][]float64, max(1, duration/ticksPerFrame))
+	for i := range frames {
+		frames[i] = make([]float64, 55)
+		for k := range frames[i] {
+			frames[i][k] = float64((id+k+i)%10) / 10
+		}
+	}
+	data, _ := json.Marshal(map[string]any{"FrameIndex": first, "BlendShapes": frames})
+	return string(data)
+}
+
+// parseTextMessage 解析文本消息的消息头及消息体
+func parseTextMessage(data []byte) (map[string]string, []byte) {
+	head, body, _ := bytes.Cut(data, []byte("\r\n\r\n"))
+	header := map[string]string{}
+	for _, line := range strings.Split(string(head), "\r\n") {
+		if k, v, ok := strings.Cut(line, ":"); ok {
+			header[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+	}
+	return header, body
+}
@@ -0,0 +1,232 @@
+package go_micro_tts
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	BlendShapeCount     = 55 // 每帧混合形状的数量
+	BlendShapeFrameRate = 60 // 服务返回的混合形状帧率
+)
+
+// 口型输出类型，用于 SsmlViseme
+const (
+	VisemeRedlipsFront     = "redlips_front"    // 2D 口型图（SVG）
+	VisemeFacialExpression = "FacialExpression" // 3D 混合形状
+)
+
+// BlendShapeNames 混合形状的名称，顺序与每帧的数值一致
+// https://learn.microsoft.com/zh-cn/azure/ai-services/speech-service/how-to-speech-synthesis-viseme
+var BlendShapeNames = [BlendShapeCount]string{
+	"eyeBlinkLeft", "eyeLookDownLeft", "eyeLookInLeft", "eyeLookOutLeft", "eyeLookUpLeft", "eyeSquintLeft", "eyeWideLeft",
+	"eyeBlinkRight", "eyeLookDownRight", "eyeLookInRight", "eyeLookOutRight", "eyeLookUpRight", "eyeSquintRight", "eyeWideRight",
+	"jawForward", "jawLeft", "jawRight", "jawOpen",
+	"mouthClose", "mouthFunnel", "mouthPucker", "mouthLeft", "mouthRight", "mouthSmileLeft", "mouthSmileRight",
+	"mouthFrownLeft", "mouthFrownRight", "mouthDimpleLeft", "mouthDimpleRight", "mouthStretchLeft", "mouthStretchRight",
+	"mouthRollLower", "mouthRollUpper", "mouthShrugLower", "mouthShrugUpper", "mouthPressLeft", "mouthPressRight",
+	"mouthLowerDownLeft", "mouthLowerDownRight", "mouthUpperUpLeft", "mouthUpperUpRight",
+	"browDownLeft", "browDownRight", "browInnerUp", "browOuterUpLeft", "browOuterUpRight",
+	"cheekPuff", "cheekSquintLeft", "cheekSquintRight", "noseSneerLeft", "noseSneerRight",
+	"tongueOut", "headRoll", "leftEyeRoll", "rightEyeRoll",
+}
+
+// BlendShapeFrame 一帧混合形状
+type BlendShapeFrame struct {
+	Offset time.Duration            // 在音频中的时间
+	Values [BlendShapeCount]float64 // 各混合形状的权重，顺序参见 BlendShapeNames
+}
+
+// SsmlViseme <mstts:viseme> 请求口型输出，vtype 取值 VisemeRedlipsFront、VisemeFacialExpression
+func SsmlViseme(vtype string) *SsmlNode {
+	return newSsmlNode("mstts:viseme", []SsmlAttr{{Name: "type", Value: vtype}}, nil)
+}
+
+// animationChunk Viseme 事件中的 AnimationChunk
+type animationChunk struct {
+	FrameIndex  int         `json:"FrameIndex"`
+	BlendShapes [][]float64 `json:"BlendShapes"`
+}
+
+// BlendShapes 解析口型事件中的混合形状帧，未请求 FacialExpression 时返回 nil
+func (v *Viseme) BlendShapes() ([]BlendShapeFrame, error) {
+	if v.Animation == "" {
+		return nil, nil
+	}
+
+	chunk := &animationChunk{}
+	if err := json.Unmarshal([]byte(v.Animation), chunk); err != nil {
+		return nil, fmt.Errorf("viseme animation: %w", err)
+	}
+
+	frames := make([]BlendShapeFrame, 0, len(chunk.BlendShapes))
+	for i, values := range chunk.BlendShapes {
+		if len(values) != BlendShapeCount {
+			return nil, fmt.Errorf("viseme animation: frame %d has %d blend shapes, want %d", chunk.FrameIndex+i, len(values), BlendShapeCount)
+		}
+		f := BlendShapeFrame{Offset: frameOffset(chunk.FrameIndex+i, BlendShapeFrameRate)}
+		copy(f.Values[:], values)
+		frames = append(frames, f)
+	}
+	return frames, nil
+}
+
+// VisemeTrack 收集实时合成中的口型及混合形状
+//
+//	track := &VisemeTrack{}
+//	err := tts.SynthesizeEvents(ctx, req, track.Add)
+type VisemeTrack struct {
+	Visemes []Viseme
+	Frames  []BlendShapeFrame
+}
+
+// Add 收集口型事件，其他事件忽略，可直接作为 SynthesizeEvents 的回调
+// 携带混合形状的事件（FacialExpression）只有 Animation 有效，仅加入 Frames
+func (t *VisemeTrack) Add(ev *SynthesisEvent) error {
+	if ev.Type != EventViseme || ev.Viseme == nil {
+		return nil
+	}
+
+	if ev.Viseme.Animation == "" {
+		t.Visemes = append(t.Visemes, *ev.Viseme)
+		return nil
+	}
+	frames, err := ev.Viseme.BlendShapes()
+	if err != nil {
+		return err
+	}
+	t.Frames = append(t.Frames, frames...)
+	return nil
+}
+
+// VisemeFrames 按帧率采样口型 ID，每帧取该时刻最近开始的口型，用于逐帧驱动 2D 口型
+func VisemeFrames(visemes []Viseme, fps float64, duration time.Duration) []int {
+	if fps <= 0 || len(visemes) == 0 {
+		return nil
+	}
+
+	n := int(math.Ceil(duration.Seconds() * fps))
+	ids := make([]int, n)
+	for i := range ids {
+		at := frameOffset(i, fps)
+		j := sort.Search(len(visemes), func(j int) bool { return visemes[j].Offset > at }) - 1
+		if j >= 0 {
+			ids[i] = visemes[j].Id
+		}
+	}
+	return ids
+}
+
+// ResampleBlendShapes 按线性插值将混合形状重采样为指定帧率，如游戏引擎使用的 30 或 24 帧
+func ResampleBlendShapes(frames []BlendShapeFrame, fps float64) []BlendShapeFrame {
+	if fps <= 0 || len(frames) == 0 {
+		return nil
+	}
+
+	last := frames[len(frames)-1].Offset
+	var res []BlendShapeFrame
+	j := 0
+	for i := 0; ; i++ {
+		at := frames[0].Offset + frameOffset(i, fps)
+		if at > last {
+			break
+		}
+		for j+1 < len(frames) && frames[j+1].Offset <= at {
+			j++
+		}
+
+		f := BlendShapeFrame{Offset: at, Values: frames[j].Values}
+		if j+1 < len(frames) {
+			a, b := frames[j], frames[j+1]
+			ratio := float64(at-a.Offset) / float64(b.Offset-a.Offset)
+			for k := range f.Values {
+				f.Values[k] = a.Values[k] + (b.Values[k]-a.Values[k])*ratio
+			}
+		}
+		res = append(res, f)
+	}
+	return res
+}
+
+// frameOffset 第 i 帧的时间
+func frameOffset(i int, fps float64) time.Duration {
+	return time.Duration(float64(i) * float64(time.Second) / fps)
+}
+
+// visemeJson 导出的口型 JSON
+type visemeJson struct {
+	Visemes []struct {
+		Id       int     `json:"id"`
+		OffsetMs float64 `json:"offsetMs"`
+	} `json:"visemes"`
+	BlendShapeNames []string    `json:"blendShapeNames,omitempty"`
+	Frames          []frameJson `json:"frames,omitempty"`
+}
+
+type frameJson struct {
+	OffsetMs float64   `json:"offsetMs"`
+	Values   []float64 `json:"values"`
+}
+
+// WriteJSON 导出为 JSON：{"visemes":[{"id","offsetMs"}],"blendShapeNames":[...],"frames":[{"offsetMs","values"}]}
+func (t *VisemeTrack) WriteJSON(w io.Writer) error {
+	out := visemeJson{}
+	for _, v := range t.Visemes {
+		out.Visemes = append(out.Visemes, struct {
+			Id       int     `json:"id"`
+			OffsetMs float64 `json:"offsetMs"`
+		}{v.Id, milliseconds(v.Offset)})
+	}
+	if len(t.Frames) > 0 {
+		out.BlendShapeNames = BlendShapeNames[:]
+	}
+	for _, f := range t.Frames {
+		out.Frames = append(out.Frames, frameJson{OffsetMs: milliseconds(f.Offset), Values: append([]float64(nil), f.Values[:]...)})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// WriteVisemesCSV 导出口型为 CSV，列为 offset_ms,viseme_id
+func WriteVisemesCSV(w io.Writer, visemes []Viseme) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"offset_ms", "viseme_id"})
+	for _, v := range visemes {
+		_ = cw.Write([]string{formatFloat(milliseconds(v.Offset)), strconv.Itoa(v.Id)})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteBlendShapesCSV 导出混合形状为 CSV，列为 frame,offset_ms 及 BlendShapeNames
+func WriteBlendShapesCSV(w io.Writer, frames []BlendShapeFrame) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write(append([]string{"frame", "offset_ms"}, BlendShapeNames[:]...))
+	record := make([]string, 2+BlendShapeCount)
+	for i, f := range frames {
+		record[0] = strconv.Itoa(i)
+		record[1] = formatFloat(milliseconds(f.Offset))
+		for k, v := range f.Values {
+			record[2+k] = formatFloat(v)
+		}
+		_ = cw.Write(record)
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,160 @@
+package go_micro_tts
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVisemeTrack(t *testing.T) {
+	tts, _ := newTestTTS(t)
+	req := &EventSynthesizeReq{
+		OutputFormat: Audio24kHz48KbitrateMonoMp3,
+		Ssml: NewSsmlSpeak("en-US").Voice("en-US-JennyNeural",
+			SsmlViseme(VisemeFacialExpression), SsmlText("Hello world")),
+		Viseme: true,
+	}
+	ssml, _ := req.Ssml.Ssml()
+	if err := ValidateSSML(ssml, nil); err != nil {
+		t.Fatalf("校验 SSML 报错 err:%v", err)
+	}
+
+	track := &VisemeTrack{}
+	if err := tts.SynthesizeEvents(context.TODO(), req, track.Add); err != nil {
+		t.Fatalf("实时合成报错 err:%v", err)
+	}
+	// 混合形状事件只加入 Frames
+	if len(track.Visemes) != 0 {
+		t.Fatalf("visemes = %+v", track.Visemes)
+	}
+	if len(track.Frames) == 0 || track.Frames[0].Offset != 0 {
+		t.Fatalf("frames = %d", len(track.Frames))
+	}
+	for i := 1; i < len(track.Frames); i++ {
+		if track.Frames[i].Offset <= track.Frames[i-1].Offset {
+			t.Fatalf("第 %d 帧时间未递增: %v", i, track.Frames[i].Offset)
+		}
+	}
+
+	req.Ssml = NewSsmlSpeak("en-US").Voice("en-US-JennyNeural", SsmlText("Hello world"))
+	track = &VisemeTrack{}
+	if err := tts.SynthesizeEvents(context.TODO(), req, track.Add); err != nil {
+		t.Fatalf("实时合成报错 err:%v", err)
+	}
+	if len(track.Visemes) != 2 || track.Visemes[0].Id == 0 || len(track.Frames) != 0 {
+		t.Fatalf("visemes = %+v, frames = %d", track.Visemes, len(track.Frames))
+	}
+}
+
+func TestVisemeBlendShapes(t *testing.T) {
+	values := make([]float64, BlendShapeCount)
+	values[17] = 0.5
+	chunk, _ := json.Marshal(map[string]any{"FrameIndex": 60, "BlendShapes": [][]float64{values, values}})
+
+	frames, err := (&Viseme{Animation: string(chunk)}).BlendShapes()
+	if err != nil {
+		t.Fatalf("解析混合形状报错 err:%v", err)
+	}
+	if len(frames) != 2 || frames[0].Offset != time.Second || frames[1].Values[17] != 0.5 {
+		t.Errorf("frames = %+v", frames)
+	}
+	if BlendShapeNames[17] != "jawOpen" {
+		t.Errorf("BlendShapeNames[17] = %s", BlendShapeNames[17])
+	}
+
+	if frames, err := (&Viseme{}).BlendShapes(); err != nil || frames != nil {
+		t.Errorf("无动画时 frames = %v, err = %v", frames, err)
+	}
+	if _, err := (&Viseme{Animation: `{"FrameIndex":0,"BlendShapes":[[1,2]]}`}).BlendShapes(); err == nil {
+		t.Error("数值数量错误时应返回错误")
+	}
+}
+
+func TestResampleBlendShapes(t *testing.T) {
+	var frames []BlendShapeFrame
+	for i := 0; i <= 60; i++ {
+		f := BlendShapeFrame{Offset: frameOffset(i, BlendShapeFrameRate)}
+		f.Values[0] = float64(i)
+		frames = append(frames, f)
+	}
+
+	res := ResampleBlendShapes(frames, 24)
+	if len(res) != 25 {
+		t.Fatalf("len = %d", len(res))
+	}
+	if res[1].Offset != time.Second/24 || math.Abs(res[1].Values[0]-2.5) > 1e-6 {
+		t.Errorf("res[1] = %v %v", res[1].Offset, res[1].Values[0])
+	}
+	if res[24].Offset != time.Second || res[24].Values[0] != 60 {
+		t.Errorf("res[24] = %v %v", res[24].Offset, res[24].Values[0])
+	}
+	if ResampleBlendShapes(nil, 30) != nil {
+		t.Error("空帧应返回 nil")
+	}
+}
+
+func TestVisemeFrames(t *testing.T) {
+	visemes := []Viseme{{Id: 0}, {Id: 5, Offset: 100 * time.Millisecond}, {Id: 9, Offset: 250 * time.Millisecond}}
+	ids := VisemeFrames(visemes, 10, 400*time.Millisecond)
+	want := []int{0, 5, 5, 9}
+	if len(ids) != len(want) {
+		t.Fatalf("ids = %v", ids)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("ids = %v, want %v", ids, want)
+		}
+	}
+}
+
+func TestVisemeExport(t *testing.T) {
+	track := &VisemeTrack{
+		Visemes: []Viseme{{Id: 1, Offset: 50 * time.Millisecond}},
+		Frames:  []BlendShapeFrame{{Offset: 1500 * time.Microsecond}},
+	}
+	track.Frames[0].Values[17] = 0.25
+
+	buf := &bytes.Buffer{}
+	if err := WriteVisemesCSV(buf, track.Visemes); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "offset_ms,viseme_id\n50,1\n" {
+		t.Errorf("visemes csv = %q", buf.String())
+	}
+
+	buf.Reset()
+	if err := WriteBlendShapesCSV(buf, track.Frames); err != nil {
+		t.Fatal(err)
+	}
+	records, err := csv.NewReader(buf).ReadAll()
+	if err != nil || len(records) != 2 || len(records[0]) != 2+BlendShapeCount {
+		t.Fatalf("blend shapes csv = %v, err = %v", records, err)
+	}
+	if records[0][19] != "jawOpen" || records[1][1] != "1.5" || records[1][19] != "0.25" {
+		t.Errorf("blend shapes csv = %v", records[1][:20])
+	}
+
+	buf.Reset()
+	if err := track.WriteJSON(buf); err != nil {
+		t.Fatal(err)
+	}
+	var out struct {
+		Visemes         []struct{ Id int }
+		BlendShapeNames []string
+		Frames          []struct {
+			OffsetMs float64
+			Values   []float64
+		}
+	}
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Visemes) != 1 || len(out.BlendShapeNames) != BlendShapeCount || out.Frames[0].OffsetMs != 1.5 || out.Frames[0].Values[17] != 0.25 {
+		t.Errorf("json = %s", strings.TrimSpace(buf.String())[:80])
+	}
+}
@@ -0,0 +1,63 @@
+package go_micro_tts
+
+import "strings"
+
+//go:generate go run ./internal/gen/voices -in voiceList.md -out voice_snapshot.json -go voices_gen.go
+
+// Voice 语音短名称，如 VoiceZhCNYunxiNeural，全部语音参见 voices_gen.go
+type Voice string
+
+// Locale 区域设置，如 LocaleZhCN
+type Locale string
+
+// Gender 语音性别
+type Gender string
+
+const (
+	GenderFemale  Gender = "Female"
+	GenderMale    Gender = "Male"
+	GenderNeutral Gender = "Neutral"
+)
+
+// voiceMeta 语音的区域设置及性别
+type voiceMeta struct {
+	locale Locale
+	gender Gender
+}
+
+// String 实现 fmt.Stringer
+func (v Voice) String() string {
+	return string(v)
+}
+
+// Known 是否为生成常量时语音列表中已有的语音
+func (v Voice) Known() bool {
+	_, ok := voiceMetas[v]
+	return ok
+}
+
+// Locale 语音的区域设置，不在语音列表中的语音按名称推断，如 zh-CN-XxxNeural 返回 zh-CN
+func (v Voice) Locale() Locale {
+	if m, ok := voiceMetas[v]; ok {
+		return m.locale
+	}
+	if i := strings.LastIndex(string(v), "-"); i > 0 {
+		return Locale(v[:i])
+	}
+	return ""
+}
+
+// Gender 语音的性别，不在语音列表中的语音返回空字符串
+func (v Voice) Gender() Gender {
+	return voiceMetas[v].gender
+}
+
+// String 实现 fmt.Stringer
+func (l Locale) String() string {
+	return string(l)
+}
+
+// String 实现 fmt.Stringer
+func (g Gender) String() string {
+	return string(g)
+}
@@ -0,0 +1,351 @@
+package go_micro_tts
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/xuemingjings/go-micro-tts/internal"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultVoiceCacheTTL 语音目录默认的缓存时间
+const defaultVoiceCacheTTL = 24 * time.Hour
+
+// VoiceCatalog 语音目录，提供按区域设置、性别、风格等条件查询语音
+type VoiceCatalog struct {
+	voices []VoiceList
+	index  map[string]int // 小写的短名称 -> 下标
+}
+
+// NewVoiceCatalog 由语音列表创建语音目录
+func NewVoiceCatalog(voices []VoiceList) *VoiceCatalog {
+	c := &VoiceCatalog{
+		voices: voices,
+		index:  make(map[string]int, len(voices)),
+	}
+	for i, v := range voices {
+		c.index[strings.ToLower(v.ShortName)] = i
+	}
+	return c
+}
+
+// Voices 返回全部语音
+func (c *VoiceCatalog) Voices() []VoiceList {
+	return c.voices
+}
+
+// Len 语音数量
+func (c *VoiceCatalog) Len() int {
+	return len(c.voices)
+}
+
+// FindVoice 按短名称查找语音，名称不区分大小写，实现 VoiceFinder
+func (c *VoiceCatalog) FindVoice(shortName string) (*VoiceList, bool) {
+	i, ok := c.index[strings.ToLower(shortName)]
+	if !ok {
+		return nil, false
+	}
+	return &c.voices[i], true
+}
+
+// Locales 返回所有区域设置，按字母排序
+func (c *VoiceCatalog) Locales() []string {
+	seen := map[string]bool{}
+	var locales []string
+	for _, v := range c.voices {
+		if !seen[v.Locale] {
+			seen[v.Locale] = true
+			locales = append(locales, v.Locale)
+		}
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// VoiceQuery 语音查询条件，为空的条件不参与过滤，字符串比较不区分大小写
+type VoiceQuery struct {
+	Locale       string // 区域设置，如 zh-CN；多语言语音的 SecondaryLocaleList 也参与匹配
+	Language     string // 语言，如 zh 匹配 zh-CN、zh-TW 等
+	Gender       string // 性别：Male、Female、Neutral
+	VoiceType    string // 语音类型，如 Neural
+	Status       string // 状态：GA、Preview
+	Style        string // 支持的说话风格，如 cheerful
+	Role         string // 支持的角色扮演，如 Boy
+	Multilingual bool   // 只返回多语言语音
+}
+
+// Query 按条件查询语音
+func (c *VoiceCatalog) Query(q VoiceQuery) []VoiceList {
+	return c.Filter(q.match)
+}
+
+// Filter 返回满足 fn 的语音
+func (c *VoiceCatalog) Filter(fn func(v *VoiceList) bool) []VoiceList {
+	var res []VoiceList
+	for i := range c.voices {
+		if fn(&c.voices[i]) {
+			res = append(res, c.voices[i])
+		}
+	}
+	return res
+}
+
+// ByLocale 按区域设置查询语音
+func (c *VoiceCatalog) ByLocale(locale string) []VoiceList {
+	return c.Query(VoiceQuery{Locale: locale})
+}
+
+// ByGender 按性别查询语音
+func (c *VoiceCatalog) ByGender(gender string) []VoiceList {
+	return c.Query(VoiceQuery{Gender: gender})
+}
+
+// ByStyle 查询支持指定说话风格的语音
+func (c *VoiceCatalog) ByStyle(style string) []VoiceList {
+	return c.Query(VoiceQuery{Style: style})
+}
+
+func (q VoiceQuery) match(v *VoiceList) bool {
+	if q.Locale != "" && !v.SupportsLocale(q.Locale) {
+		return false
+	}
+	if q.Language != "" && !strings.EqualFold(strings.SplitN(v.Locale, "-", 2)[0], q.Language) {
+		return false
+	}
+	if q.Gender != "" && !strings.EqualFold(v.Gender, q.Gender) {
+		return false
+	}
+	if q.VoiceType != "" && !strings.EqualFold(v.VoiceType, q.VoiceType) {
+		return false
+	}
+	if q.Status != "" && !strings.EqualFold(v.Status, q.Status) {
+		return false
+	}
+	if q.Style != "" && !v.SupportsStyle(q.Style) {
+		return false
+	}
+	if q.Role != "" && !v.SupportsRole(q.Role) {
+		return false
+	}
+	if q.Multilingual && !v.IsMultilingual() {
+		return false
+	}
+	return true
+}
+
+// IsMultilingual 是否为多语言语音
+func (v *VoiceList) IsMultilingual() bool {
+	return len(v.SecondaryLocaleList) > 0 || strings.Contains(v.ShortName, "Multilingual")
+}
+
+// SupportsLocale 语音能否朗读该区域设置的文本
+func (v *VoiceList) SupportsLocale(locale string) bool {
+	if strings.EqualFold(v.Locale, locale) {
+		return true
+	}
+	for _, l := range v.SecondaryLocaleList {
+		if strings.EqualFold(l, locale) {
+			return true
+		}
+	}
+	// 未返回 SecondaryLocaleList 的多语言语音按支持处理
+	return len(v.SecondaryLocaleList) == 0 && strings.Contains(v.ShortName, "Multilingual")
+}
+
+// SupportsStyle 是否支持该说话风格
+func (v *VoiceList) SupportsStyle(style string) bool {
+	return containsFold(v.StyleList, style)
+}
+
+// SupportsRole 是否支持该角色扮演
+func (v *VoiceList) SupportsRole(role string) bool {
+	return containsFold(v.RolePlayList, role)
+}
+
+// IsGA 是否为正式发布的语音
+func (v *VoiceList) IsGA() bool {
+	return strings.EqualFold(v.Status, "GA")
+}
+
+// IsPreview 是否为预览版语音
+func (v *VoiceList) IsPreview() bool {
+	return strings.EqualFold(v.Status, "Preview")
+}
+
+// WPM 每分钟朗读的单词数，未知时返回 0
+func (v *VoiceList) WPM() int {
+	wpm, _ := strconv.Atoi(v.WordsPerMinute)
+	return wpm
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithVoiceCacheTTL 设置 GetVoiceCatalog 的缓存时间，默认 24 小时
+func WithVoiceCacheTTL(ttl time.Duration) Option {
+	return func(g *GoTTS) {
+		g.voiceCache.ttl = ttl
+	}
+}
+
+// WithVoiceCacheFile 将语音目录持久化到文件，进程重启后在缓存时间内无需重新请求
+func WithVoiceCacheFile(path string) Option {
+	return func(g *GoTTS) {
+		g.voiceCache.path = path
+	}
+}
+
+// voiceCache 语音目录的缓存
+type voiceCache struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	path      string
+	catalog   *VoiceCatalog
+	fetchedAt time.Time
+	call      *voiceCatalogCall // 正在进行中的刷新
+}
+
+// voiceCatalogCall 一次刷新语音目录的请求，并发调用方共享同一个结果
+type voiceCatalogCall struct {
+	done    chan struct{}
+	force   bool          // 忽略缓存文件，直接请求语音列表接口
+	catalog *VoiceCatalog // 刷新失败时为过期的缓存
+	err     error
+}
+
+// voiceCacheFile 缓存文件的内容
+type voiceCacheFile struct {
+	FetchedAt time.Time   `json:"fetchedAt"`
+	Voices    []VoiceList `json:"voices"`
+}
+
+// GetVoiceCatalog 获取语音目录，优先使用内存及文件缓存，缓存过期后才请求语音列表接口
+// 并发调用时共享同一次刷新；请求失败时若有过期的缓存则返回过期的缓存；缓存文件写入失败不影响返回结果
+func (g *GoTTS) GetVoiceCatalog(ctx context.Context) (*VoiceCatalog, error) {
+	catalog, err := g.waitVoiceCatalog(ctx, false)
+	if err != nil && catalog != nil {
+		return catalog, nil
+	}
+	return catalog, err
+}
+
+// RefreshVoiceCatalog 忽略缓存重新请求语音列表接口，并更新缓存
+// 请求失败时返回错误，若有缓存则同时返回缓存的语音目录
+func (g *GoTTS) RefreshVoiceCatalog(ctx context.Context) (*VoiceCatalog, error) {
+	return g.waitVoiceCatalog(ctx, true)
+}
+
+// waitVoiceCatalog 缓存未过期且 force 为 false 时直接返回，否则发起刷新或等待进行中的刷新
+// 刷新使用与调用方 ctx 分离的上下文，单个调用方取消 ctx 只会停止等待，不会中断其他调用方共享的刷新
+func (g *GoTTS) waitVoiceCatalog(ctx context.Context, force bool) (*VoiceCatalog, error) {
+	vc := &g.voiceCache
+
+	for {
+		vc.mu.Lock()
+		if !force && vc.catalog != nil && time.Since(vc.fetchedAt) < vc.ttl {
+			catalog := vc.catalog
+			vc.mu.Unlock()
+			return catalog, nil
+		}
+		c := vc.call
+		if c == nil {
+			c = &voiceCatalogCall{done: make(chan struct{}), force: force}
+			vc.call = c
+			go g.refreshVoiceCatalog(context.WithoutCancel(ctx), c)
+		}
+		vc.mu.Unlock()
+
+		select {
+		case <-c.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		// 进行中的刷新可能使用了缓存文件，强制刷新时等待其结束后再请求一次
+		if c.force || !force {
+			return c.catalog, c.err
+		}
+	}
+}
+
+// refreshVoiceCatalog 执行一次刷新并通知所有等待的调用方
+// 未强制刷新时优先使用未过期的缓存文件（可能由其他进程写入），否则请求语音列表接口；
+// 读写缓存文件及请求接口期间不持有 voiceCache.mu
+func (g *GoTTS) refreshVoiceCatalog(ctx context.Context, c *voiceCatalogCall) {
+	vc := &g.voiceCache
+
+	var file *voiceCacheFile
+	if vc.path != "" && !c.force {
+		file, _ = readVoiceCacheFile(vc.path)
+	}
+
+	var voices []VoiceList
+	var fetchedAt time.Time
+	if file != nil && time.Since(file.FetchedAt) < vc.ttl {
+		voices, fetchedAt = file.Voices, file.FetchedAt
+	} else if list, err := g.GetVoiceListContext(ctx); err == nil {
+		voices, fetchedAt = *list, time.Now()
+		// 缓存文件仅用于加速下次启动，写入失败（如目录只读）时忽略
+		if vc.path != "" {
+			_ = writeVoiceCacheFile(vc.path, &voiceCacheFile{FetchedAt: fetchedAt, Voices: voices})
+		}
+	} else {
+		c.err = err
+		// 请求失败时使用过期的缓存文件
+		if file != nil {
+			voices, fetchedAt = file.Voices, file.FetchedAt
+		}
+	}
+
+	var catalog *VoiceCatalog
+	if !fetchedAt.IsZero() {
+		catalog = NewVoiceCatalog(voices)
+	}
+
+	vc.mu.Lock()
+	// 保留较新的结果
+	if catalog != nil && fetchedAt.After(vc.fetchedAt) {
+		vc.catalog = catalog
+		vc.fetchedAt = fetchedAt
+	}
+	c.catalog = vc.catalog
+	vc.call = nil
+	vc.mu.Unlock()
+	close(c.done)
+}
+
+func readVoiceCacheFile(path string) (*voiceCacheFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &voiceCacheFile{}
+	if err := json.Unmarshal(data, f); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func writeVoiceCacheFile(path string, f *voiceCacheFile) error {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+
+	_, err = internal.WriteFileAtomic(path, 0o644, func(w io.Writer) (int64, error) {
+		n, err := w.Write(data)
+		return int64(n), err
+	})
+	return err
+}
@@ -0,0 +1,178 @@
+package go_micro_tts
+
+import (
+	"context"
+	"github.com/xuemingjings/go-micro-tts/ttstest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestVoiceCatalogQuery(t *testing.T) {
+	tts, _ := newTestTTS(t)
+
+	catalog, err := tts.GetVoiceCatalog(context.TODO())
+	if err != nil {
+		t.Fatalf("获取语音目录报错 err:%v", err)
+	}
+
+	if catalog.Len() != 3 {
+		t.Fatalf("Len() = %d", catalog.Len())
+	}
+	if v, ok := catalog.FindVoice("zh-cn-yunxineural"); !ok || v.WPM() != 293 || !v.SupportsRole("boy") {
+		t.Errorf("FindVoice = %+v, %v", v, ok)
+	}
+	if got := catalog.Locales(); len(got) != 2 || got[0] != "en-US" || got[1] != "zh-CN" {
+		t.Errorf("Locales() = %v", got)
+	}
+
+	cases := []struct {
+		name  string
+		query VoiceQuery
+		want  []string
+	}{
+		{"locale", VoiceQuery{Locale: "zh-CN"}, []string{"zh-CN-YunxiNeural", "en-US-AvaMultilingualNeural"}},
+		{"language", VoiceQuery{Language: "en"}, []string{"en-US-JennyNeural", "en-US-AvaMultilingualNeural"}},
+		{"gender", VoiceQuery{Gender: "female", Status: "GA"}, []string{"en-US-JennyNeural"}},
+		{"style", VoiceQuery{Style: "Cheerful", Locale: "en-US"}, []string{"en-US-JennyNeural"}},
+		{"multilingual", VoiceQuery{Multilingual: true}, []string{"en-US-AvaMultilingualNeural"}},
+		{"preview", VoiceQuery{Status: "Preview", VoiceType: "Neural"}, []string{"en-US-AvaMultilingualNeural"}},
+		{"none", VoiceQuery{Locale: "de-DE"}, nil},
+	}
+	for _, c := range cases {
+		got := catalog.Query(c.query)
+		if len(got) != len(c.want) {
+			t.Errorf("%s: Query = %d voices, want %v", c.name, len(got), c.want)
+			continue
+		}
+		for i, v := range got {
+			if v.ShortName != c.want[i] {
+				t.Errorf("%s: Query[%d] = %s, want %s", c.name, i, v.ShortName, c.want[i])
+			}
+		}
+	}
+}
+
+func TestVoiceCatalogCache(t *testing.T) {
+	tts, srv := newTestTTS(t)
+
+	for i := 0; i < 2; i++ {
+		if _, err := tts.GetVoiceCatalog(context.TODO()); err != nil {
+			t.Fatalf("获取语音目录报错 err:%v", err)
+		}
+	}
+	if n := srv.Requests(ttstest.PathVoiceList); n != 1 {
+		t.Errorf("语音列表请求次数 = %d，期望缓存命中", n)
+	}
+
+	if _, err := tts.RefreshVoiceCatalog(context.TODO()); err != nil {
+		t.Fatalf("刷新语音目录报错 err:%v", err)
+	}
+	if n := srv.Requests(ttstest.PathVoiceList); n != 2 {
+		t.Errorf("刷新后语音列表请求次数 = %d", n)
+	}
+
+	tts.voiceCache.ttl = time.Nanosecond
+	if _, err := tts.GetVoiceCatalog(context.TODO()); err != nil {
+		t.Fatalf("获取语音目录报错 err:%v", err)
+	}
+	if n := srv.Requests(ttstest.PathVoiceList); n != 3 {
+		t.Errorf("缓存过期后语音列表请求次数 = %d", n)
+	}
+}
+
+func TestVoiceCatalogConcurrent(t *testing.T) {
+	tts, srv := newTestTTS(t)
+	tts.voiceCache.ttl = time.Hour
+
+	for round := 1; round <= 2; round++ {
+		var wg sync.WaitGroup
+		errs := make(chan error, 20)
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := tts.GetVoiceCatalog(context.TODO())
+				errs <- err
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			if err != nil {
+				t.Fatalf("获取语音目录报错 err:%v", err)
+			}
+		}
+		if n := srv.Requests(ttstest.PathVoiceList); n != round {
+			t.Errorf("第 %d 轮并发调用时语音列表请求次数 = %d", round, n)
+		}
+
+		// 缓存过期后再并发调用一轮
+		tts.voiceCache.mu.Lock()
+		tts.voiceCache.fetchedAt = time.Now().Add(-2 * time.Hour)
+		tts.voiceCache.mu.Unlock()
+	}
+}
+
+func TestVoiceCatalogCacheFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "voices.json")
+
+	tts, srv := newTestTTS(t)
+	WithVoiceCacheFile(path)(tts)
+	if _, err := tts.GetVoiceCatalog(context.TODO()); err != nil {
+		t.Fatalf("获取语音目录报错 err:%v", err)
+	}
+
+	// 新实例从缓存文件加载，不再请求接口
+	tts2, srv2 := newTestTTS(t)
+	WithVoiceCacheFile(path)(tts2)
+	catalog, err := tts2.GetVoiceCatalog(context.TODO())
+	if err != nil {
+		t.Fatalf("获取语音目录报错 err:%v", err)
+	}
+	if catalog.Len() != 3 {
+		t.Errorf("Len() = %d", catalog.Len())
+	}
+	if v, ok := catalog.FindVoice("en-US-AvaMultilingualNeural"); !ok || !v.SupportsLocale("ja-JP") || v.SupportsLocale("de-DE") {
+		t.Errorf("FindVoice = %+v, %v", v, ok)
+	}
+	if n := srv.Requests(ttstest.PathVoiceList) + srv2.Requests(ttstest.PathVoiceList); n != 1 {
+		t.Errorf("语音列表请求次数 = %d", n)
+	}
+}
+
+func TestVoiceCatalogCacheFileUnwritable(t *testing.T) {
+	// 缓存文件所在目录不存在，写入失败不影响获取语音目录
+	path := filepath.Join(t.TempDir(), "missing", "voices.json")
+
+	tts, _ := newTestTTS(t)
+	WithVoiceCacheFile(path)(tts)
+	catalog, err := tts.GetVoiceCatalog(context.TODO())
+	if err != nil || catalog == nil || catalog.Len() != 3 {
+		t.Fatalf("catalog = %v, err = %v", catalog, err)
+	}
+}
+
+func TestVoiceCatalogStaleFallback(t *testing.T) {
+	tts, srv := newTestTTS(t)
+	if _, err := tts.GetVoiceCatalog(context.TODO()); err != nil {
+		t.Fatalf("获取语音目录报错 err:%v", err)
+	}
+
+	tts.voiceCache.ttl = time.Nanosecond
+	srv.Fail(ttstest.PathVoiceList, 2, 500, "InternalServerError", "boom")
+
+	// 请求失败时返回过期的缓存
+	catalog, err := tts.GetVoiceCatalog(context.TODO())
+	if err != nil || catalog == nil || catalog.Len() != 3 {
+		t.Fatalf("catalog = %v, err = %v", catalog, err)
+	}
+
+	// 强制刷新失败时返回错误及缓存
+	catalog, err = tts.RefreshVoiceCatalog(context.TODO())
+	if err == nil || catalog == nil {
+		t.Fatalf("catalog = %v, err = %v", catalog, err)
+	}
+}
@@ -0,0 +1,101 @@
+package go_micro_tts
+
+import (
+	_ "embed"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// voiceSnapshot 内置的语音列表快照，由 voiceList.md 生成
+//
+//go:embed voice_snapshot.json
+var voiceSnapshot []byte
+
+var (
+	snapshotOnce    sync.Once
+	snapshotCatalog *VoiceCatalog
+)
+
+// SnapshotVoiceCatalog 返回内置的语音目录快照，无需网络即可用于语音查询及 SSML 校验
+// 快照可能落后于线上的语音列表，可通过 Merge 合并 GetVoiceCatalog 的结果
+func SnapshotVoiceCatalog() *VoiceCatalog {
+	snapshotOnce.Do(func() {
+		var voices []VoiceList
+		if err := json.Unmarshal(voiceSnapshot, &voices); err != nil {
+			panic("go_micro_tts: invalid voice snapshot: " + err.Error())
+		}
+		snapshotCatalog = NewVoiceCatalog(voices)
+	})
+	return snapshotCatalog
+}
+
+// Merge 合并两个语音目录并返回新的目录，短名称相同时以 other 为准，仅存在于 c 中的语音保留在原位置
+//
+//	catalog := SnapshotVoiceCatalog().Merge(live)
+func (c *VoiceCatalog) Merge(other *VoiceCatalog) *VoiceCatalog {
+	voices := make([]VoiceList, 0, len(c.voices)+len(other.voices))
+	for _, v := range c.voices {
+		if o, ok := other.FindVoice(v.ShortName); ok {
+			v = *o
+		}
+		voices = append(voices, v)
+	}
+	for _, v := range other.voices {
+		if _, ok := c.FindVoice(v.ShortName); !ok {
+			voices = append(voices, v)
+		}
+	}
+	return NewVoiceCatalog(voices)
+}
+
+// Suggest 返回与 name 最相近的最多 n 个语音，用于提示拼写错误的语音名称
+func (c *VoiceCatalog) Suggest(name string, n int) []VoiceList {
+	type candidate struct {
+		index    int
+		distance int
+	}
+
+	name = strings.ToLower(name)
+	// 编辑距离超过名称长度的一半时认为不相近
+	limit := len(name)/2 + 1
+
+	var candidates []candidate
+	for i, v := range c.voices {
+		if d := editDistance(name, strings.ToLower(v.ShortName)); d <= limit {
+			candidates = append(candidates, candidate{index: i, distance: d})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+
+	var res []VoiceList
+	for i := 0; i < len(candidates) && i < n; i++ {
+		res = append(res, c.voices[candidates[i].index])
+	}
+	return res
+}
+
+// editDistance 两个字符串的 Levenshtein 编辑距离
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,69 @@
+package go_micro_tts
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestSnapshotVoiceCatalog(t *testing.T) {
+	catalog := SnapshotVoiceCatalog()
+
+	if catalog.Len() != 465 {
+		t.Errorf("Len() = %d", catalog.Len())
+	}
+	v, ok := catalog.FindVoice("zh-CN-YunxiNeural")
+	if !ok || v.Gender != "Male" || v.WPM() == 0 {
+		t.Errorf("FindVoice = %+v, %v", v, ok)
+	}
+	if len(catalog.Query(VoiceQuery{Locale: "zh-CN", Gender: "Female"})) == 0 {
+		t.Error("快照中没有 zh-CN 女声")
+	}
+}
+
+func TestVoiceCatalogMerge(t *testing.T) {
+	tts, _ := newTestTTS(t)
+
+	live, err := tts.GetVoiceCatalog(context.TODO())
+	if err != nil {
+		t.Fatalf("获取语音目录报错 err:%v", err)
+	}
+
+	snapshot := SnapshotVoiceCatalog()
+	merged := snapshot.Merge(live)
+
+	v, _ := merged.FindVoice("zh-CN-YunxiNeural")
+	if v.WordsPerMinute != "293" {
+		t.Errorf("合并后应以线上结果为准 %+v", v)
+	}
+	if _, ok := merged.FindVoice("af-ZA-AdriNeural"); !ok {
+		t.Error("合并后缺少快照中的语音")
+	}
+	if _, ok := snapshot.FindVoice("en-US-AvaMultilingualNeural"); !ok {
+		if merged.Len() != snapshot.Len()+1 {
+			t.Errorf("Len() = %d", merged.Len())
+		}
+	}
+	if merged.Voices()[0].ShortName != snapshot.Voices()[0].ShortName {
+		t.Error("合并后应保持快照的顺序")
+	}
+}
+
+func TestVoiceCatalogSuggest(t *testing.T) {
+	catalog := SnapshotVoiceCatalog()
+
+	got := catalog.Suggest("zh-CN-YunxiNerual", 3)
+	if len(got) == 0 || got[0].ShortName != "zh-CN-YunxiNeural" {
+		t.Errorf("Suggest = %v", got)
+	}
+	if got := catalog.Suggest("foo", 3); len(got) != 0 {
+		t.Errorf("Suggest(foo) = %v", got)
+	}
+
+	doc := NewSsmlSpeak("zh-CN").Voice("zh-CN-YunxiNerual", SsmlText("你好"))
+	data, _ := doc.Ssml()
+	err := ValidateSSML(data, &SsmlValidateOptions{Voices: catalog})
+	if err == nil || !strings.Contains(err.Error(), `did you mean "zh-CN-YunxiNeural"`) {
+		t.Errorf("err = %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+package go_micro_tts
+
+import "testing"
+
+func TestVoice(t *testing.T) {
+	cases := []struct {
+		voice  Voice
+		known  bool
+		locale Locale
+		gender Gender
+	}{
+		{VoiceZhCNYunxiNeural, true, LocaleZhCN, GenderMale},
+		{VoiceZhCNSichuanYunxiNeural, true, LocaleZhCNSichuan, GenderMale},
+		{VoiceSrLatnRSSophieNeural, true, LocaleSrLatnRS, GenderFemale},
+		{"en-US-UnknownNeural", false, LocaleEnUS, ""},
+		{"unknown", false, "", ""},
+	}
+	for _, c := range cases {
+		if c.voice.Known() != c.known || c.voice.Locale() != c.locale || c.voice.Gender() != c.gender {
+			t.Errorf("%s: Known() = %v, Locale() = %q, Gender() = %q", c.voice, c.voice.Known(), c.voice.Locale(), c.voice.Gender())
+		}
+	}
+
+	// 生成的常量与内置快照一致
+	catalog := SnapshotVoiceCatalog()
+	for _, v := range catalog.Voices() {
+		voice := Voice(v.ShortName)
+		if !voice.Known() || string(voice.Locale()) != v.Locale || string(voice.Gender()) != v.Gender {
+			t.Errorf("%s: Locale() = %q, Gender() = %q", voice, voice.Locale(), voice.Gender())
+		}
+	}
+}
+
+func TestNewSpeakXmlFromVoice(t *testing.T) {
+	ssml := NewVoiceSpeakXml(VoiceZhCNYunxiNeural, "你好")
+	if ssml.Lang != "zh-CN" || ssml.Voice.Lang != "zh-CN" || ssml.Voice.Gender != "Male" || ssml.Voice.Name != "zh-CN-YunxiNeural" {
+		t.Errorf("NewVoiceSpeakXml = %+v", ssml)
+	}
+
+	// 显式设置的值优先
+	ssml = NewSpeakXml(&SpeakXmlReq{Lang: "en-US", Name: string(VoiceZhCNYunxiNeural), Text: "hi"})
+	if ssml.Lang != "en-US" || ssml.Voice.Gender != "Male" {
+		t.Errorf("NewSpeakXml = %+v", ssml)
+	}
+
+	// 语音列表之外的新语音按名称推断区域设置
+	ssml = NewSpeakXml(&SpeakXmlReq{Name: "zh-CN-NewVoiceNeural", Text: "你好"})
+	if ssml.Lang != "zh-CN" || ssml.Voice.Gender != "" {
+		t.Errorf("NewSpeakXml = %+v", ssml)
+	}
+	if _, err := ssml.Ssml(); err != nil {
+		t.Errorf("Ssml 报错 err:%v", err)
+	}
+}
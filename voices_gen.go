@@ -0,0 +1,1093 @@
+// Code generated by internal/gen/voices; DO NOT EDIT.
+
+package go_micro_tts
+
+// 语音列表中的全部语音
+const (
+	VoiceAfZAAdriNeural               Voice = "af-ZA-AdriNeural"               // Adri，Female
+	VoiceAfZAWillemNeural             Voice = "af-ZA-WillemNeural"             // Willem，Male
+	VoiceAmETMekdesNeural             Voice = "am-ET-MekdesNeural"             // መቅደስ，Female
+	VoiceAmETAmehaNeural              Voice = "am-ET-AmehaNeural"              // አምሀ，Male
+	VoiceArAEFatimaNeural             Voice = "ar-AE-FatimaNeural"             // فاطمة，Female
+	VoiceArAEHamdanNeural             Voice = "ar-AE-HamdanNeural"             // حمدان，Male
+	VoiceArBHLailaNeural              Voice = "ar-BH-LailaNeural"              // ليلى，Female
+	VoiceArBHAliNeural                Voice = "ar-BH-AliNeural"                // علي，Male
+	VoiceArDZAminaNeural              Voice = "ar-DZ-AminaNeural"              // أمينة，Female
+	VoiceArDZIsmaelNeural             Voice = "ar-DZ-IsmaelNeural"             // إسماعيل，Male
+	VoiceArEGSalmaNeural              Voice = "ar-EG-SalmaNeural"              // سلمى，Female
+	VoiceArEGShakirNeural             Voice = "ar-EG-ShakirNeural"             // شاكر，Male
+	VoiceArIQRanaNeural               Voice = "ar-IQ-RanaNeural"               // رنا，Female
+	VoiceArIQBasselNeural             Voice = "ar-IQ-BasselNeural"             // باسل，Male
+	VoiceArJOSanaNeural               Voice = "ar-JO-SanaNeural"               // سناء，Female
+	VoiceArJOTaimNeural               Voice = "ar-JO-TaimNeural"               // تيم，Male
+	VoiceArKWNouraNeural              Voice = "ar-KW-NouraNeural"              // نورا，Female
+	VoiceArKWFahedNeural              Voice = "ar-KW-FahedNeural"              // فهد，Male
+	VoiceArLBLaylaNeural              Voice = "ar-LB-LaylaNeural"              // ليلى，Female
+	VoiceArLBRamiNeural               Voice = "ar-LB-RamiNeural"               // رامي，Male
+	VoiceArLYImanNeural               Voice = "ar-LY-ImanNeural"               // إيمان，Female
+	VoiceArLYOmarNeural               Voice = "ar-LY-OmarNeural"               // أحمد，Male
+	VoiceArMAMounaNeural              Voice = "ar-MA-MounaNeural"              // منى，Female
+	VoiceArMAJamalNeural              Voice = "ar-MA-JamalNeural"              // جمال，Male
+	VoiceArOMAyshaNeural              Voice = "ar-OM-AyshaNeural"              // عائشة，Female
+	VoiceArOMAbdullahNeural           Voice = "ar-OM-AbdullahNeural"           // عبدالله，Male
+	VoiceArQAAmalNeural               Voice = "ar-QA-AmalNeural"               // أمل，Female
+	VoiceArQAMoazNeural               Voice = "ar-QA-MoazNeural"               // معاذ，Male
+	VoiceArSAZariyahNeural            Voice = "ar-SA-ZariyahNeural"            // زارية，Female
+	VoiceArSAHamedNeural              Voice = "ar-SA-HamedNeural"              // حامد，Male
+	VoiceArSYAmanyNeural              Voice = "ar-SY-AmanyNeural"              // أماني，Female
+	VoiceArSYLaithNeural              Voice = "ar-SY-LaithNeural"              // ليث，Male
+	VoiceArTNReemNeural               Voice = "ar-TN-ReemNeural"               // ريم，Female
+	VoiceArTNHediNeural               Voice = "ar-TN-HediNeural"               // هادي，Male
+	VoiceArYEMaryamNeural             Voice = "ar-YE-MaryamNeural"             // مريم，Female
+	VoiceArYESalehNeural              Voice = "ar-YE-SalehNeural"              // صالح，Male
+	VoiceAzAZBanuNeural               Voice = "az-AZ-BanuNeural"               // Banu，Female
+	VoiceAzAZBabekNeural              Voice = "az-AZ-BabekNeural"              // Babək，Male
+	VoiceBgBGKalinaNeural             Voice = "bg-BG-KalinaNeural"             // Калина，Female
+	VoiceBgBGBorislavNeural           Voice = "bg-BG-BorislavNeural"           // Борислав，Male
+	VoiceBnBDNabanitaNeural           Voice = "bn-BD-NabanitaNeural"           // নবনীতা，Female
+	VoiceBnBDPradeepNeural            Voice = "bn-BD-PradeepNeural"            // প্রদ্বীপ，Male
+	VoiceBnINTanishaaNeural           Voice = "bn-IN-TanishaaNeural"           // তানিশা，Female
+	VoiceBnINBashkarNeural            Voice = "bn-IN-BashkarNeural"            // ভাস্কর，Male
+	VoiceBsBAVesnaNeural              Voice = "bs-BA-VesnaNeural"              // Vesna，Female
+	VoiceBsBAGoranNeural              Voice = "bs-BA-GoranNeural"              // Goran，Male
+	VoiceCaESJoanaNeural              Voice = "ca-ES-JoanaNeural"              // Joana，Female
+	VoiceCaESEnricNeural              Voice = "ca-ES-EnricNeural"              // Enric，Male
+	VoiceCaESAlbaNeural               Voice = "ca-ES-AlbaNeural"               // Alba，Female
+	VoiceCsCZVlastaNeural             Voice = "cs-CZ-VlastaNeural"             // Vlasta，Female
+	VoiceCsCZAntoninNeural            Voice = "cs-CZ-AntoninNeural"            // Antonín，Male
+	VoiceCyGBNiaNeural                Voice = "cy-GB-NiaNeural"                // Nia，Female
+	VoiceCyGBAledNeural               Voice = "cy-GB-AledNeural"               // Aled，Male
+	VoiceDaDKChristelNeural           Voice = "da-DK-ChristelNeural"           // Christel，Female
+	VoiceDaDKJeppeNeural              Voice = "da-DK-JeppeNeural"              // Jeppe，Male
+	VoiceDeATIngridNeural             Voice = "de-AT-IngridNeural"             // Ingrid，Female
+	VoiceDeATJonasNeural              Voice = "de-AT-JonasNeural"              // Jonas，Male
+	VoiceDeCHLeniNeural               Voice = "de-CH-LeniNeural"               // Leni，Female
+	VoiceDeCHJanNeural                Voice = "de-CH-JanNeural"                // Jan，Male
+	VoiceDeDEKatjaNeural              Voice = "de-DE-KatjaNeural"              // Katja，Female
+	VoiceDeDEConradNeural             Voice = "de-DE-ConradNeural"             // Conrad，Male
+	VoiceDeDEAmalaNeural              Voice = "de-DE-AmalaNeural"              // Amala，Female
+	VoiceDeDEBerndNeural              Voice = "de-DE-BerndNeural"              // Bernd，Male
+	VoiceDeDEChristophNeural          Voice = "de-DE-ChristophNeural"          // Christoph，Male
+	VoiceDeDEElkeNeural               Voice = "de-DE-ElkeNeural"               // Elke，Female
+	VoiceDeDEGiselaNeural             Voice = "de-DE-GiselaNeural"             // Gisela，Female
+	VoiceDeDEKasperNeural             Voice = "de-DE-KasperNeural"             // Kasper，Male
+	VoiceDeDEKillianNeural            Voice = "de-DE-KillianNeural"            // Killian，Male
+	VoiceDeDEKlarissaNeural           Voice = "de-DE-KlarissaNeural"           // Klarissa，Female
+	VoiceDeDEKlausNeural              Voice = "de-DE-KlausNeural"              // Klaus，Male
+	VoiceDeDELouisaNeural             Voice = "de-DE-LouisaNeural"             // Louisa，Female
+	VoiceDeDEMajaNeural               Voice = "de-DE-MajaNeural"               // Maja，Female
+	VoiceDeDERalfNeural               Voice = "de-DE-RalfNeural"               // Ralf，Male
+	VoiceDeDETanjaNeural              Voice = "de-DE-TanjaNeural"              // Tanja，Female
+	VoiceElGRAthinaNeural             Voice = "el-GR-AthinaNeural"             // Αθηνά，Female
+	VoiceElGRNestorasNeural           Voice = "el-GR-NestorasNeural"           // Νέστορας，Male
+	VoiceEnAUNatashaNeural            Voice = "en-AU-NatashaNeural"            // Natasha，Female
+	VoiceEnAUWilliamNeural            Voice = "en-AU-WilliamNeural"            // William，Male
+	VoiceEnAUAnnetteNeural            Voice = "en-AU-AnnetteNeural"            // Annette，Female
+	VoiceEnAUCarlyNeural              Voice = "en-AU-CarlyNeural"              // Carly，Female
+	VoiceEnAUDarrenNeural             Voice = "en-AU-DarrenNeural"             // Darren，Male
+	VoiceEnAUDuncanNeural             Voice = "en-AU-DuncanNeural"             // Duncan，Male
+	VoiceEnAUElsieNeural              Voice = "en-AU-ElsieNeural"              // Elsie，Female
+	VoiceEnAUFreyaNeural              Voice = "en-AU-FreyaNeural"              // Freya，Female
+	VoiceEnAUJoanneNeural             Voice = "en-AU-JoanneNeural"             // Joanne，Female
+	VoiceEnAUKenNeural                Voice = "en-AU-KenNeural"                // Ken，Male
+	VoiceEnAUKimNeural                Voice = "en-AU-KimNeural"                // Kim，Female
+	VoiceEnAUNeilNeural               Voice = "en-AU-NeilNeural"               // Neil，Male
+	VoiceEnAUTimNeural                Voice = "en-AU-TimNeural"                // Tim，Male
+	VoiceEnAUTinaNeural               Voice = "en-AU-TinaNeural"               // Tina，Female
+	VoiceEnCAClaraNeural              Voice = "en-CA-ClaraNeural"              // Clara，Female
+	VoiceEnCALiamNeural               Voice = "en-CA-LiamNeural"               // Liam，Male
+	VoiceEnGBSoniaNeural              Voice = "en-GB-SoniaNeural"              // Sonia，Female
+	VoiceEnGBRyanNeural               Voice = "en-GB-RyanNeural"               // Ryan，Male
+	VoiceEnGBLibbyNeural              Voice = "en-GB-LibbyNeural"              // Libby，Female
+	VoiceEnGBAbbiNeural               Voice = "en-GB-AbbiNeural"               // Abbi，Female
+	VoiceEnGBAlfieNeural              Voice = "en-GB-AlfieNeural"              // Alfie，Male
+	VoiceEnGBBellaNeural              Voice = "en-GB-BellaNeural"              // Bella，Female
+	VoiceEnGBElliotNeural             Voice = "en-GB-ElliotNeural"             // Elliot，Male
+	VoiceEnGBEthanNeural              Voice = "en-GB-EthanNeural"              // Ethan，Male
+	VoiceEnGBHollieNeural             Voice = "en-GB-HollieNeural"             // Hollie，Female
+	VoiceEnGBMaisieNeural             Voice = "en-GB-MaisieNeural"             // Maisie，Female
+	VoiceEnGBNoahNeural               Voice = "en-GB-NoahNeural"               // Noah，Male
+	VoiceEnGBOliverNeural             Voice = "en-GB-OliverNeural"             // Oliver，Male
+	VoiceEnGBOliviaNeural             Voice = "en-GB-OliviaNeural"             // Olivia，Female
+	VoiceEnGBThomasNeural             Voice = "en-GB-ThomasNeural"             // Thomas，Male
+	VoiceEnGBMiaNeural                Voice = "en-GB-MiaNeural"                // Mia，Female
+	VoiceEnHKYanNeural                Voice = "en-HK-YanNeural"                // Yan，Female
+	VoiceEnHKSamNeural                Voice = "en-HK-SamNeural"                // Sam，Male
+	VoiceEnIEEmilyNeural              Voice = "en-IE-EmilyNeural"              // Emily，Female
+	VoiceEnIEConnorNeural             Voice = "en-IE-ConnorNeural"             // Connor，Male
+	VoiceEnINNeerjaNeural             Voice = "en-IN-NeerjaNeural"             // Neerja，Female
+	VoiceEnINPrabhatNeural            Voice = "en-IN-PrabhatNeural"            // Prabhat，Male
+	VoiceEnKEAsiliaNeural             Voice = "en-KE-AsiliaNeural"             // Asilia，Female
+	VoiceEnKEChilembaNeural           Voice = "en-KE-ChilembaNeural"           // Chilemba，Male
+	VoiceEnNGEzinneNeural             Voice = "en-NG-EzinneNeural"             // Ezinne，Female
+	VoiceEnNGAbeoNeural               Voice = "en-NG-AbeoNeural"               // Abeo，Male
+	VoiceEnNZMollyNeural              Voice = "en-NZ-MollyNeural"              // Molly，Female
+	VoiceEnNZMitchellNeural           Voice = "en-NZ-MitchellNeural"           // Mitchell，Male
+	VoiceEnPHRosaNeural               Voice = "en-PH-RosaNeural"               // Rosa，Female
+	VoiceEnPHJamesNeural              Voice = "en-PH-JamesNeural"              // James，Male
+	VoiceEnSGLunaNeural               Voice = "en-SG-LunaNeural"               // Luna，Female
+	VoiceEnSGWayneNeural              Voice = "en-SG-WayneNeural"              // Wayne，Male
+	VoiceEnTZImaniNeural              Voice = "en-TZ-ImaniNeural"              // Imani，Female
+	VoiceEnTZElimuNeural              Voice = "en-TZ-ElimuNeural"              // Elimu，Male
+	VoiceEnUSAvaNeural                Voice = "en-US-AvaNeural"                // Ava，Female
+	VoiceEnUSAndrewNeural             Voice = "en-US-AndrewNeural"             // Andrew，Male
+	VoiceEnUSEmmaNeural               Voice = "en-US-EmmaNeural"               // Emma，Female
+	VoiceEnUSBrianNeural              Voice = "en-US-BrianNeural"              // Brian，Male
+	VoiceEnUSJennyNeural              Voice = "en-US-JennyNeural"              // Jenny，Female
+	VoiceEnUSGuyNeural                Voice = "en-US-GuyNeural"                // Guy，Male
+	VoiceEnUSAriaNeural               Voice = "en-US-AriaNeural"               // Aria，Female
+	VoiceEnUSDavisNeural              Voice = "en-US-DavisNeural"              // Davis，Male
+	VoiceEnUSJaneNeural               Voice = "en-US-JaneNeural"               // Jane，Female
+	VoiceEnUSJasonNeural              Voice = "en-US-JasonNeural"              // Jason，Male
+	VoiceEnUSSaraNeural               Voice = "en-US-SaraNeural"               // Sara，Female
+	VoiceEnUSTonyNeural               Voice = "en-US-TonyNeural"               // Tony，Male
+	VoiceEnUSNancyNeural              Voice = "en-US-NancyNeural"              // Nancy，Female
+	VoiceEnUSAmberNeural              Voice = "en-US-AmberNeural"              // Amber，Female
+	VoiceEnUSAnaNeural                Voice = "en-US-AnaNeural"                // Ana，Female
+	VoiceEnUSAndrewMultilingualNeural Voice = "en-US-AndrewMultilingualNeural" // AndrewMultilingualNeural，Male
+	VoiceEnUSAshleyNeural             Voice = "en-US-AshleyNeural"             // Ashley，Female
+	VoiceEnUSAvaMultilingualNeural    Voice = "en-US-AvaMultilingualNeural"    // AvaMultilingualNeural，Female
+	VoiceEnUSBrandonNeural            Voice = "en-US-BrandonNeural"            // Brandon，Male
+	VoiceEnUSBrianMultilingualNeural  Voice = "en-US-BrianMultilingualNeural"  // BrianMultilingualNeural，Male
+	VoiceEnUSChristopherNeural        Voice = "en-US-ChristopherNeural"        // Christopher，Male
+	VoiceEnUSCoraNeural               Voice = "en-US-CoraNeural"               // Cora，Female
+	VoiceEnUSElizabethNeural          Voice = "en-US-ElizabethNeural"          // Elizabeth，Female
+	VoiceEnUSEmmaMultilingualNeural   Voice = "en-US-EmmaMultilingualNeural"   // EmmaMultilingualNeural，Female
+	VoiceEnUSEricNeural               Voice = "en-US-EricNeural"               // Eric，Male
+	VoiceEnUSJacobNeural              Voice = "en-US-JacobNeural"              // Jacob，Male
+	VoiceEnUSJennyMultilingualNeural  Voice = "en-US-JennyMultilingualNeural"  // Jenny Multilingual，Female
+	VoiceEnUSMichelleNeural           Voice = "en-US-MichelleNeural"           // Michelle，Female
+	VoiceEnUSMonicaNeural             Voice = "en-US-MonicaNeural"             // Monica，Female
+	VoiceEnUSRogerNeural              Voice = "en-US-RogerNeural"              // Roger，Male
+	VoiceEnUSRyanMultilingualNeural   Voice = "en-US-RyanMultilingualNeural"   // Ryan Multilingual，Male
+	VoiceEnUSSteffanNeural            Voice = "en-US-SteffanNeural"            // Steffan，Male
+	VoiceEnZALeahNeural               Voice = "en-ZA-LeahNeural"               // Leah，Female
+	VoiceEnZALukeNeural               Voice = "en-ZA-LukeNeural"               // Luke，Male
+	VoiceEsARElenaNeural              Voice = "es-AR-ElenaNeural"              // Elena，Female
+	VoiceEsARTomasNeural              Voice = "es-AR-TomasNeural"              // Tomas，Male
+	VoiceEsBOSofiaNeural              Voice = "es-BO-SofiaNeural"              // Sofia，Female
+	VoiceEsBOMarceloNeural            Voice = "es-BO-MarceloNeural"            // Marcelo，Male
+	VoiceEsCLCatalinaNeural           Voice = "es-CL-CatalinaNeural"           // Catalina，Female
+	VoiceEsCLLorenzoNeural            Voice = "es-CL-LorenzoNeural"            // Lorenzo，Male
+	VoiceEsCOSalomeNeural             Voice = "es-CO-SalomeNeural"             // Salome，Female
+	VoiceEsCOGonzaloNeural            Voice = "es-CO-GonzaloNeural"            // Gonzalo，Male
+	VoiceEsCRMariaNeural              Voice = "es-CR-MariaNeural"              // María，Female
+	VoiceEsCRJuanNeural               Voice = "es-CR-JuanNeural"               // Juan，Male
+	VoiceEsCUBelkysNeural             Voice = "es-CU-BelkysNeural"             // Belkys，Female
+	VoiceEsCUManuelNeural             Voice = "es-CU-ManuelNeural"             // Manuel，Male
+	VoiceEsDORamonaNeural             Voice = "es-DO-RamonaNeural"             // Ramona，Female
+	VoiceEsDOEmilioNeural             Voice = "es-DO-EmilioNeural"             // Emilio，Male
+	VoiceEsECAndreaNeural             Voice = "es-EC-AndreaNeural"             // Andrea，Female
+	VoiceEsECLuisNeural               Voice = "es-EC-LuisNeural"               // Luis，Male
+	VoiceEsESElviraNeural             Voice = "es-ES-ElviraNeural"             // Elvira，Female
+	VoiceEsESAlvaroNeural             Voice = "es-ES-AlvaroNeural"             // Álvaro，Male
+	VoiceEsESAbrilNeural              Voice = "es-ES-AbrilNeural"              // Abril，Female
+	VoiceEsESArnauNeural              Voice = "es-ES-ArnauNeural"              // Arnau，Male
+	VoiceEsESDarioNeural              Voice = "es-ES-DarioNeural"              // Dario，Male
+	VoiceEsESEliasNeural              Voice = "es-ES-EliasNeural"              // Elias，Male
+	VoiceEsESEstrellaNeural           Voice = "es-ES-EstrellaNeural"           // Estrella，Female
+	VoiceEsESIreneNeural              Voice = "es-ES-IreneNeural"              // Irene，Female
+	VoiceEsESLaiaNeural               Voice = "es-ES-LaiaNeural"               // Laia，Female
+	VoiceEsESLiaNeural                Voice = "es-ES-LiaNeural"                // Lia，Female
+	VoiceEsESNilNeural                Voice = "es-ES-NilNeural"                // Nil，Male
+	VoiceEsESSaulNeural               Voice = "es-ES-SaulNeural"               // Saul，Male
+	VoiceEsESTeoNeural                Voice = "es-ES-TeoNeural"                // Teo，Male
+	VoiceEsESTrianaNeural             Voice = "es-ES-TrianaNeural"             // Triana，Female
+	VoiceEsESVeraNeural               Voice = "es-ES-VeraNeural"               // Vera，Female
+	VoiceEsGQTeresaNeural             Voice = "es-GQ-TeresaNeural"             // Teresa，Female
+	VoiceEsGQJavierNeural             Voice = "es-GQ-JavierNeural"             // Javier，Male
+	VoiceEsGTMartaNeural              Voice = "es-GT-MartaNeural"              // Marta，Female
+	VoiceEsGTAndresNeural             Voice = "es-GT-AndresNeural"             // Andrés，Male
+	VoiceEsHNKarlaNeural              Voice = "es-HN-KarlaNeural"              // Karla，Female
+	VoiceEsHNCarlosNeural             Voice = "es-HN-CarlosNeural"             // Carlos，Male
+	VoiceEsMXDaliaNeural              Voice = "es-MX-DaliaNeural"              // Dalia，Female
+	VoiceEsMXJorgeNeural              Voice = "es-MX-JorgeNeural"              // Jorge，Male
+	VoiceEsMXBeatrizNeural            Voice = "es-MX-BeatrizNeural"            // Beatriz，Female
+	VoiceEsMXCandelaNeural            Voice = "es-MX-CandelaNeural"            // Candela，Female
+	VoiceEsMXCarlotaNeural            Voice = "es-MX-CarlotaNeural"            // Carlota，Female
+	VoiceEsMXCecilioNeural            Voice = "es-MX-CecilioNeural"            // Cecilio，Male
+	VoiceEsMXGerardoNeural            Voice = "es-MX-GerardoNeural"            // Gerardo，Male
+	VoiceEsMXLarissaNeural            Voice = "es-MX-LarissaNeural"            // Larissa，Female
+	VoiceEsMXLibertoNeural            Voice = "es-MX-LibertoNeural"            // Liberto，Male
+	VoiceEsMXLucianoNeural            Voice = "es-MX-LucianoNeural"            // Luciano，Male
+	VoiceEsMXMarinaNeural             Voice = "es-MX-MarinaNeural"             // Marina，Female
+	VoiceEsMXNuriaNeural              Voice = "es-MX-NuriaNeural"              // Nuria，Female
+	VoiceEsMXPelayoNeural             Voice = "es-MX-PelayoNeural"             // Pelayo，Male
+	VoiceEsMXRenataNeural             Voice = "es-MX-RenataNeural"             // Renata，Female
+	VoiceEsMXYagoNeural               Voice = "es-MX-YagoNeural"               // Yago，Male
+	VoiceEsNIYolandaNeural            Voice = "es-NI-YolandaNeural"            // Yolanda，Female
+	VoiceEsNIFedericoNeural           Voice = "es-NI-FedericoNeural"           // Federico，Male
+	VoiceEsPAMargaritaNeural          Voice = "es-PA-MargaritaNeural"          // Margarita，Female
+	VoiceEsPARobertoNeural            Voice = "es-PA-RobertoNeural"            // Roberto，Male
+	VoiceEsPECamilaNeural             Voice = "es-PE-CamilaNeural"             // Camila，Female
+	VoiceEsPEAlexNeural               Voice = "es-PE-AlexNeural"               // Alex，Male
+	VoiceEsPRKarinaNeural             Voice = "es-PR-KarinaNeural"             // Karina，Female
+	VoiceEsPRVictorNeural             Voice = "es-PR-VictorNeural"             // Víctor，Male
+	VoiceEsPYTaniaNeural              Voice = "es-PY-TaniaNeural"              // Tania，Female
+	VoiceEsPYMarioNeural              Voice = "es-PY-MarioNeural"              // Mario，Male
+	VoiceEsSVLorenaNeural             Voice = "es-SV-LorenaNeural"             // Lorena，Female
+	VoiceEsSVRodrigoNeural            Voice = "es-SV-RodrigoNeural"            // Rodrigo，Male
+	VoiceEsUSPalomaNeural             Voice = "es-US-PalomaNeural"             // Paloma，Female
+	VoiceEsUSAlonsoNeural             Voice = "es-US-AlonsoNeural"             // Alonso，Male
+	VoiceEsUYValentinaNeural          Voice = "es-UY-ValentinaNeural"          // Valentina，Female
+	VoiceEsUYMateoNeural              Voice = "es-UY-MateoNeural"              // Mateo，Male
+	VoiceEsVEPaolaNeural              Voice = "es-VE-PaolaNeural"              // Paola，Female
+	VoiceEsVESebastianNeural          Voice = "es-VE-SebastianNeural"          // Sebastián，Male
+	VoiceEtEEAnuNeural                Voice = "et-EE-AnuNeural"                // Anu，Female
+	VoiceEtEEKertNeural               Voice = "et-EE-KertNeural"               // Kert，Male
+	VoiceEuESAinhoaNeural             Voice = "eu-ES-AinhoaNeural"             // Ainhoa，Female
+	VoiceEuESAnderNeural              Voice = "eu-ES-AnderNeural"              // Ander，Male
+	VoiceFaIRDilaraNeural             Voice = "fa-IR-DilaraNeural"             // دلارا，Female
+	VoiceFaIRFaridNeural              Voice = "fa-IR-FaridNeural"              // فرید，Male
+	VoiceFiFISelmaNeural              Voice = "fi-FI-SelmaNeural"              // Selma，Female
+	VoiceFiFIHarriNeural              Voice = "fi-FI-HarriNeural"              // Harri，Male
+	VoiceFiFINooraNeural              Voice = "fi-FI-NooraNeural"              // Noora，Female
+	VoiceFilPHBlessicaNeural          Voice = "fil-PH-BlessicaNeural"          // Blessica，Female
+	VoiceFilPHAngeloNeural            Voice = "fil-PH-AngeloNeural"            // Angelo，Male
+	VoiceFrBECharlineNeural           Voice = "fr-BE-CharlineNeural"           // Charline，Female
+	VoiceFrBEGerardNeural             Voice = "fr-BE-GerardNeural"             // Gerard，Male
+	VoiceFrCASylvieNeural             Voice = "fr-CA-SylvieNeural"             // Sylvie，Female
+	VoiceFrCAJeanNeural               Voice = "fr-CA-JeanNeural"               // Jean，Male
+	VoiceFrCAAntoineNeural            Voice = "fr-CA-AntoineNeural"            // Antoine，Male
+	VoiceFrCHArianeNeural             Voice = "fr-CH-ArianeNeural"             // Ariane，Female
+	VoiceFrCHFabriceNeural            Voice = "fr-CH-FabriceNeural"            // Fabrice，Male
+	VoiceFrFRDeniseNeural             Voice = "fr-FR-DeniseNeural"             // Denise，Female
+	VoiceFrFRHenriNeural              Voice = "fr-FR-HenriNeural"              // Henri，Male
+	VoiceFrFRAlainNeural              Voice = "fr-FR-AlainNeural"              // Alain，Male
+	VoiceFrFRBrigitteNeural           Voice = "fr-FR-BrigitteNeural"           // Brigitte，Female
+	VoiceFrFRCelesteNeural            Voice = "fr-FR-CelesteNeural"            // Celeste，Female
+	VoiceFrFRClaudeNeural             Voice = "fr-FR-ClaudeNeural"             // Claude，Male
+	VoiceFrFRCoralieNeural            Voice = "fr-FR-CoralieNeural"            // Coralie，Female
+	VoiceFrFREloiseNeural             Voice = "fr-FR-EloiseNeural"             // Eloise，Female
+	VoiceFrFRJacquelineNeural         Voice = "fr-FR-JacquelineNeural"         // Jacqueline，Female
+	VoiceFrFRJeromeNeural             Voice = "fr-FR-JeromeNeural"             // Jerome，Male
+	VoiceFrFRJosephineNeural          Voice = "fr-FR-JosephineNeural"          // Josephine，Female
+	VoiceFrFRMauriceNeural            Voice = "fr-FR-MauriceNeural"            // Maurice，Male
+	VoiceFrFRYvesNeural               Voice = "fr-FR-YvesNeural"               // Yves，Male
+	VoiceFrFRYvetteNeural             Voice = "fr-FR-YvetteNeural"             // Yvette，Female
+	VoiceGaIEOrlaNeural               Voice = "ga-IE-OrlaNeural"               // Orla，Female
+	VoiceGaIEColmNeural               Voice = "ga-IE-ColmNeural"               // Colm，Male
+	VoiceGlESSabelaNeural             Voice = "gl-ES-SabelaNeural"             // Sabela，Female
+	VoiceGlESRoiNeural                Voice = "gl-ES-RoiNeural"                // Roi，Male
+	VoiceGuINDhwaniNeural             Voice = "gu-IN-DhwaniNeural"             // ધ્વની，Female
+	VoiceGuINNiranjanNeural           Voice = "gu-IN-NiranjanNeural"           // નિરંજન，Male
+	VoiceHeILHilaNeural               Voice = "he-IL-HilaNeural"               // הילה，Female
+	VoiceHeILAvriNeural               Voice = "he-IL-AvriNeural"               // אברי，Male
+	VoiceHiINSwaraNeural              Voice = "hi-IN-SwaraNeural"              // स्वरा，Female
+	VoiceHiINMadhurNeural             Voice = "hi-IN-MadhurNeural"             // मधुर，Male
+	VoiceHrHRGabrijelaNeural          Voice = "hr-HR-GabrijelaNeural"          // Gabrijela，Female
+	VoiceHrHRSreckoNeural             Voice = "hr-HR-SreckoNeural"             // Srećko，Male
+	VoiceHuHUNoemiNeural              Voice = "hu-HU-NoemiNeural"              // Noémi，Female
+	VoiceHuHUTamasNeural              Voice = "hu-HU-TamasNeural"              // Tamás，Male
+	VoiceHyAMAnahitNeural             Voice = "hy-AM-AnahitNeural"             // Անահիտ，Female
+	VoiceHyAMHaykNeural               Voice = "hy-AM-HaykNeural"               // Հայկ，Male
+	VoiceIdIDGadisNeural              Voice = "id-ID-GadisNeural"              // Gadis，Female
+	VoiceIdIDArdiNeural               Voice = "id-ID-ArdiNeural"               // Ardi，Male
+	VoiceIsISGudrunNeural             Voice = "is-IS-GudrunNeural"             // Guðrún，Female
+	VoiceIsISGunnarNeural             Voice = "is-IS-GunnarNeural"             // Gunnar，Male
+	VoiceItITElsaNeural               Voice = "it-IT-ElsaNeural"               // Elsa，Female
+	VoiceItITIsabellaNeural           Voice = "it-IT-IsabellaNeural"           // Isabella，Female
+	VoiceItITDiegoNeural              Voice = "it-IT-DiegoNeural"              // Diego，Male
+	VoiceItITBenignoNeural            Voice = "it-IT-BenignoNeural"            // Benigno，Male
+	VoiceItITCalimeroNeural           Voice = "it-IT-CalimeroNeural"           // Calimero，Male
+	VoiceItITCataldoNeural            Voice = "it-IT-CataldoNeural"            // Cataldo，Male
+	VoiceItITFabiolaNeural            Voice = "it-IT-FabiolaNeural"            // Fabiola，Female
+	VoiceItITFiammaNeural             Voice = "it-IT-FiammaNeural"             // Fiamma，Female
+	VoiceItITGianniNeural             Voice = "it-IT-GianniNeural"             // Gianni，Male
+	VoiceItITImeldaNeural             Voice = "it-IT-ImeldaNeural"             // Imelda，Female
+	VoiceItITIrmaNeural               Voice = "it-IT-IrmaNeural"               // Irma，Female
+	VoiceItITLisandroNeural           Voice = "it-IT-LisandroNeural"           // Lisandro，Male
+	VoiceItITPalmiraNeural            Voice = "it-IT-PalmiraNeural"            // Palmira，Female
+	VoiceItITPierinaNeural            Voice = "it-IT-PierinaNeural"            // Pierina，Female
+	VoiceItITRinaldoNeural            Voice = "it-IT-RinaldoNeural"            // Rinaldo，Male
+	VoiceJaJPNanamiNeural             Voice = "ja-JP-NanamiNeural"             // 七海，Female
+	VoiceJaJPKeitaNeural              Voice = "ja-JP-KeitaNeural"              // 圭太，Male
+	VoiceJaJPAoiNeural                Voice = "ja-JP-AoiNeural"                // 碧衣，Female
+	VoiceJaJPDaichiNeural             Voice = "ja-JP-DaichiNeural"             // 大智，Male
+	VoiceJaJPMayuNeural               Voice = "ja-JP-MayuNeural"               // 真夕，Female
+	VoiceJaJPNaokiNeural              Voice = "ja-JP-NaokiNeural"              // 直紀，Male
+	VoiceJaJPShioriNeural             Voice = "ja-JP-ShioriNeural"             // 志織，Female
+	VoiceJvIDSitiNeural               Voice = "jv-ID-SitiNeural"               // Siti，Female
+	VoiceJvIDDimasNeural              Voice = "jv-ID-DimasNeural"              // Dimas，Male
+	VoiceKaGEEkaNeural                Voice = "ka-GE-EkaNeural"                // ეკა，Female
+	VoiceKaGEGiorgiNeural             Voice = "ka-GE-GiorgiNeural"             // გიორგი，Male
+	VoiceKkKZAigulNeural              Voice = "kk-KZ-AigulNeural"              // Айгүл，Female
+	VoiceKkKZDauletNeural             Voice = "kk-KZ-DauletNeural"             // Дәулет，Male
+	VoiceKmKHSreymomNeural            Voice = "km-KH-SreymomNeural"            // ស្រីមុំ，Female
+	VoiceKmKHPisethNeural             Voice = "km-KH-PisethNeural"             // ពិសិដ្ឋ，Male
+	VoiceKnINSapnaNeural              Voice = "kn-IN-SapnaNeural"              // ಸಪ್ನಾ，Female
+	VoiceKnINGaganNeural              Voice = "kn-IN-GaganNeural"              // ಗಗನ್，Male
+	VoiceKoKRSunHiNeural              Voice = "ko-KR-SunHiNeural"              // 선히，Female
+	VoiceKoKRInJoonNeural             Voice = "ko-KR-InJoonNeural"             // 인준，Male
+	VoiceKoKRBongJinNeural            Voice = "ko-KR-BongJinNeural"            // 봉진，Male
+	VoiceKoKRGookMinNeural            Voice = "ko-KR-GookMinNeural"            // 국민，Male
+	VoiceKoKRJiMinNeural              Voice = "ko-KR-JiMinNeural"              // 지민，Female
+	VoiceKoKRSeoHyeonNeural           Voice = "ko-KR-SeoHyeonNeural"           // 서현，Female
+	VoiceKoKRSoonBokNeural            Voice = "ko-KR-SoonBokNeural"            // 순복，Female
+	VoiceKoKRYuJinNeural              Voice = "ko-KR-YuJinNeural"              // 유진，Female
+	VoiceLoLAKeomanyNeural            Voice = "lo-LA-KeomanyNeural"            // ແກ້ວມະນີ，Female
+	VoiceLoLAChanthavongNeural        Voice = "lo-LA-ChanthavongNeural"        // ຈັນທະວົງ，Male
+	VoiceLtLTOnaNeural                Voice = "lt-LT-OnaNeural"                // Ona，Female
+	VoiceLtLTLeonasNeural             Voice = "lt-LT-LeonasNeural"             // Leonas，Male
+	VoiceLvLVEveritaNeural            Voice = "lv-LV-EveritaNeural"            // Everita，Female
+	VoiceLvLVNilsNeural               Voice = "lv-LV-NilsNeural"               // Nils，Male
+	VoiceMkMKMarijaNeural             Voice = "mk-MK-MarijaNeural"             // Марија，Female
+	VoiceMkMKAleksandarNeural         Voice = "mk-MK-AleksandarNeural"         // Александар，Male
+	VoiceMlINSobhanaNeural            Voice = "ml-IN-SobhanaNeural"            // ശോഭന，Female
+	VoiceMlINMidhunNeural             Voice = "ml-IN-MidhunNeural"             // മിഥുൻ，Male
+	VoiceMnMNYesuiNeural              Voice = "mn-MN-YesuiNeural"              // Есүй，Female
+	VoiceMnMNBataaNeural              Voice = "mn-MN-BataaNeural"              // Батаа，Male
+	VoiceMrINAarohiNeural             Voice = "mr-IN-AarohiNeural"             // आरोही，Female
+	VoiceMrINManoharNeural            Voice = "mr-IN-ManoharNeural"            // मनोहर，Male
+	VoiceMsMYYasminNeural             Voice = "ms-MY-YasminNeural"             // Yasmin，Female
+	VoiceMsMYOsmanNeural              Voice = "ms-MY-OsmanNeural"              // Osman，Male
+	VoiceMtMTGraceNeural              Voice = "mt-MT-GraceNeural"              // Grace，Female
+	VoiceMtMTJosephNeural             Voice = "mt-MT-JosephNeural"             // Joseph，Male
+	VoiceMyMMNilarNeural              Voice = "my-MM-NilarNeural"              // နီလာ，Female
+	VoiceMyMMThihaNeural              Voice = "my-MM-ThihaNeural"              // သီဟ，Male
+	VoiceNbNOPernilleNeural           Voice = "nb-NO-PernilleNeural"           // Pernille，Female
+	VoiceNbNOFinnNeural               Voice = "nb-NO-FinnNeural"               // Finn，Male
+	VoiceNbNOIselinNeural             Voice = "nb-NO-IselinNeural"             // Iselin，Female
+	VoiceNeNPHemkalaNeural            Voice = "ne-NP-HemkalaNeural"            // हेमकला，Female
+	VoiceNeNPSagarNeural              Voice = "ne-NP-SagarNeural"              // सागर，Male
+	VoiceNlBEDenaNeural               Voice = "nl-BE-DenaNeural"               // Dena，Female
+	VoiceNlBEArnaudNeural             Voice = "nl-BE-ArnaudNeural"             // Arnaud，Male
+	VoiceNlNLFennaNeural              Voice = "nl-NL-FennaNeural"              // Fenna，Female
+	VoiceNlNLMaartenNeural            Voice = "nl-NL-MaartenNeural"            // Maarten，Male
+	VoiceNlNLColetteNeural            Voice = "nl-NL-ColetteNeural"            // Colette，Female
+	VoicePlPLAgnieszkaNeural          Voice = "pl-PL-AgnieszkaNeural"          // Agnieszka，Female
+	VoicePlPLMarekNeural              Voice = "pl-PL-MarekNeural"              // Marek，Male
+	VoicePlPLZofiaNeural              Voice = "pl-PL-ZofiaNeural"              // Zofia，Female
+	VoicePsAFLatifaNeural             Voice = "ps-AF-LatifaNeural"             // لطيفه，Female
+	VoicePsAFGulNawazNeural           Voice = "ps-AF-GulNawazNeural"           //  ګل نواز，Male
+	VoicePtBRFranciscaNeural          Voice = "pt-BR-FranciscaNeural"          // Francisca，Female
+	VoicePtBRAntonioNeural            Voice = "pt-BR-AntonioNeural"            // Antônio，Male
+	VoicePtBRBrendaNeural             Voice = "pt-BR-BrendaNeural"             // Brenda，Female
+	VoicePtBRDonatoNeural             Voice = "pt-BR-DonatoNeural"             // Donato，Male
+	VoicePtBRElzaNeural               Voice = "pt-BR-ElzaNeural"               // Elza，Female
+	VoicePtBRFabioNeural              Voice = "pt-BR-FabioNeural"              // Fabio，Male
+	VoicePtBRGiovannaNeural           Voice = "pt-BR-GiovannaNeural"           // Giovanna，Female
+	VoicePtBRHumbertoNeural           Voice = "pt-BR-HumbertoNeural"           // Humberto，Male
+	VoicePtBRJulioNeural              Voice = "pt-BR-JulioNeural"              // Julio，Male
+	VoicePtBRLeilaNeural              Voice = "pt-BR-LeilaNeural"              // Leila，Female
+	VoicePtBRLeticiaNeural            Voice = "pt-BR-LeticiaNeural"            // Leticia，Female
+	VoicePtBRManuelaNeural            Voice = "pt-BR-ManuelaNeural"            // Manuela，Female
+	VoicePtBRNicolauNeural            Voice = "pt-BR-NicolauNeural"            // Nicolau，Male
+	VoicePtBRValerioNeural            Voice = "pt-BR-ValerioNeural"            // Valerio，Male
+	VoicePtBRYaraNeural               Voice = "pt-BR-YaraNeural"               // Yara，Female
+	VoicePtPTRaquelNeural             Voice = "pt-PT-RaquelNeural"             // Raquel，Female
+	VoicePtPTDuarteNeural             Voice = "pt-PT-DuarteNeural"             // Duarte，Male
+	VoicePtPTFernandaNeural           Voice = "pt-PT-FernandaNeural"           // Fernanda，Female
+	VoiceRoROAlinaNeural              Voice = "ro-RO-AlinaNeural"              // Alina，Female
+	VoiceRoROEmilNeural               Voice = "ro-RO-EmilNeural"               // Emil，Male
+	VoiceRuRUSvetlanaNeural           Voice = "ru-RU-SvetlanaNeural"           // Светлана，Female
+	VoiceRuRUDmitryNeural             Voice = "ru-RU-DmitryNeural"             // Дмитрий，Male
+	VoiceRuRUDariyaNeural             Voice = "ru-RU-DariyaNeural"             // Дария，Female
+	VoiceSiLKThiliniNeural            Voice = "si-LK-ThiliniNeural"            // තිළිණි，Female
+	VoiceSiLKSameeraNeural            Voice = "si-LK-SameeraNeural"            // සමීර，Male
+	VoiceSkSKViktoriaNeural           Voice = "sk-SK-ViktoriaNeural"           // Viktória，Female
+	VoiceSkSKLukasNeural              Voice = "sk-SK-LukasNeural"              // Lukáš，Male
+	VoiceSlSIPetraNeural              Voice = "sl-SI-PetraNeural"              // Petra，Female
+	VoiceSlSIRokNeural                Voice = "sl-SI-RokNeural"                // Rok，Male
+	VoiceSoSOUbaxNeural               Voice = "so-SO-UbaxNeural"               // Ubax，Female
+	VoiceSoSOMuuseNeural              Voice = "so-SO-MuuseNeural"              // Muuse，Male
+	VoiceSqALAnilaNeural              Voice = "sq-AL-AnilaNeural"              // Anila，Female
+	VoiceSqALIlirNeural               Voice = "sq-AL-IlirNeural"               // Ilir，Male
+	VoiceSrLatnRSNicholasNeural       Voice = "sr-Latn-RS-NicholasNeural"      // Nicholas，Male
+	VoiceSrLatnRSSophieNeural         Voice = "sr-Latn-RS-SophieNeural"        // Sophie，Female
+	VoiceSrRSSophieNeural             Voice = "sr-RS-SophieNeural"             // Софија，Female
+	VoiceSrRSNicholasNeural           Voice = "sr-RS-NicholasNeural"           // Никола，Male
+	VoiceSuIDTutiNeural               Voice = "su-ID-TutiNeural"               // Tuti，Female
+	VoiceSuIDJajangNeural             Voice = "su-ID-JajangNeural"             // Jajang，Male
+	VoiceSvSESofieNeural              Voice = "sv-SE-SofieNeural"              // Sofie，Female
+	VoiceSvSEMattiasNeural            Voice = "sv-SE-MattiasNeural"            // Mattias，Male
+	VoiceSvSEHilleviNeural            Voice = "sv-SE-HilleviNeural"            // Hillevi，Female
+	VoiceSwKEZuriNeural               Voice = "sw-KE-ZuriNeural"               // Zuri，Female
+	VoiceSwKERafikiNeural             Voice = "sw-KE-RafikiNeural"             // Rafiki，Male
+	VoiceSwTZRehemaNeural             Voice = "sw-TZ-RehemaNeural"             // Rehema，Female
+	VoiceSwTZDaudiNeural              Voice = "sw-TZ-DaudiNeural"              // Daudi，Male
+	VoiceTaINPallaviNeural            Voice = "ta-IN-PallaviNeural"            // பல்லவி，Female
+	VoiceTaINValluvarNeural           Voice = "ta-IN-ValluvarNeural"           // வள்ளுவர்，Male
+	VoiceTaLKSaranyaNeural            Voice = "ta-LK-SaranyaNeural"            // சரண்யா，Female
+	VoiceTaLKKumarNeural              Voice = "ta-LK-KumarNeural"              // குமார்，Male
+	VoiceTaMYKaniNeural               Voice = "ta-MY-KaniNeural"               // கனி，Female
+	VoiceTaMYSuryaNeural              Voice = "ta-MY-SuryaNeural"              // சூர்யா，Male
+	VoiceTaSGVenbaNeural              Voice = "ta-SG-VenbaNeural"              // வெண்பா，Female
+	VoiceTaSGAnbuNeural               Voice = "ta-SG-AnbuNeural"               // அன்பு，Male
+	VoiceTeINShrutiNeural             Voice = "te-IN-ShrutiNeural"             // శ్రుతి，Female
+	VoiceTeINMohanNeural              Voice = "te-IN-MohanNeural"              // మోహన్，Male
+	VoiceThTHPremwadeeNeural          Voice = "th-TH-PremwadeeNeural"          // เปรมวดี，Female
+	VoiceThTHNiwatNeural              Voice = "th-TH-NiwatNeural"              // นิวัฒน์，Male
+	VoiceThTHAcharaNeural             Voice = "th-TH-AcharaNeural"             // อัจฉรา，Female
+	VoiceTrTREmelNeural               Voice = "tr-TR-EmelNeural"               // Emel，Female
+	VoiceTrTRAhmetNeural              Voice = "tr-TR-AhmetNeural"              // Ahmet，Male
+	VoiceUkUAPolinaNeural             Voice = "uk-UA-PolinaNeural"             // Поліна，Female
+	VoiceUkUAOstapNeural              Voice = "uk-UA-OstapNeural"              // Остап，Male
+	VoiceUrINGulNeural                Voice = "ur-IN-GulNeural"                // گل，Female
+	VoiceUrINSalmanNeural             Voice = "ur-IN-SalmanNeural"             // سلمان，Male
+	VoiceUrPKUzmaNeural               Voice = "ur-PK-UzmaNeural"               // عظمیٰ，Female
+	VoiceUrPKAsadNeural               Voice = "ur-PK-AsadNeural"               // اسد，Male
+	VoiceUzUZMadinaNeural             Voice = "uz-UZ-MadinaNeural"             // Madina，Female
+	VoiceUzUZSardorNeural             Voice = "uz-UZ-SardorNeural"             // Sardor，Male
+	VoiceViVNHoaiMyNeural             Voice = "vi-VN-HoaiMyNeural"             // Hoài My，Female
+	VoiceViVNNamMinhNeural            Voice = "vi-VN-NamMinhNeural"            // Nam Minh，Male
+	VoiceWuuCNXiaotongNeural          Voice = "wuu-CN-XiaotongNeural"          // 晓彤，Female
+	VoiceWuuCNYunzheNeural            Voice = "wuu-CN-YunzheNeural"            // 云哲，Male
+	VoiceYueCNXiaoMinNeural           Voice = "yue-CN-XiaoMinNeural"           // 晓敏，Female
+	VoiceYueCNYunSongNeural           Voice = "yue-CN-YunSongNeural"           // 云松，Male
+	VoiceZhCNXiaoxiaoNeural           Voice = "zh-CN-XiaoxiaoNeural"           // 晓晓，Female
+	VoiceZhCNYunxiNeural              Voice = "zh-CN-YunxiNeural"              // 云希，Male
+	VoiceZhCNYunjianNeural            Voice = "zh-CN-YunjianNeural"            // 云健，Male
+	VoiceZhCNXiaoyiNeural             Voice = "zh-CN-XiaoyiNeural"             // 晓伊，Female
+	VoiceZhCNYunyangNeural            Voice = "zh-CN-YunyangNeural"            // 云扬，Male
+	VoiceZhCNXiaochenNeural           Voice = "zh-CN-XiaochenNeural"           // 晓辰，Female
+	VoiceZhCNXiaohanNeural            Voice = "zh-CN-XiaohanNeural"            // 晓涵，Female
+	VoiceZhCNXiaomengNeural           Voice = "zh-CN-XiaomengNeural"           // 晓梦，Female
+	VoiceZhCNXiaomoNeural             Voice = "zh-CN-XiaomoNeural"             // 晓墨，Female
+	VoiceZhCNXiaoqiuNeural            Voice = "zh-CN-XiaoqiuNeural"            // 晓秋，Female
+	VoiceZhCNXiaoruiNeural            Voice = "zh-CN-XiaoruiNeural"            // 晓睿，Female
+	VoiceZhCNXiaoshuangNeural         Voice = "zh-CN-XiaoshuangNeural"         // 晓双，Female
+	VoiceZhCNXiaoyanNeural            Voice = "zh-CN-XiaoyanNeural"            // 晓颜，Female
+	VoiceZhCNXiaoyouNeural            Voice = "zh-CN-XiaoyouNeural"            // 晓悠，Female
+	VoiceZhCNXiaozhenNeural           Voice = "zh-CN-XiaozhenNeural"           // 晓甄，Female
+	VoiceZhCNYunfengNeural            Voice = "zh-CN-YunfengNeural"            // 云枫，Male
+	VoiceZhCNYunhaoNeural             Voice = "zh-CN-YunhaoNeural"             // 云皓，Male
+	VoiceZhCNYunxiaNeural             Voice = "zh-CN-YunxiaNeural"             // 云夏，Male
+	VoiceZhCNYunyeNeural              Voice = "zh-CN-YunyeNeural"              // 云野，Male
+	VoiceZhCNYunzeNeural              Voice = "zh-CN-YunzeNeural"              // 云泽，Male
+	VoiceZhCNXiaoxuanNeural           Voice = "zh-CN-XiaoxuanNeural"           // 晓萱，Female
+	VoiceZhCNHenanYundengNeural       Voice = "zh-CN-henan-YundengNeural"      // 云登，Male
+	VoiceZhCNLiaoningXiaobeiNeural    Voice = "zh-CN-liaoning-XiaobeiNeural"   // 晓北，Female
+	VoiceZhCNShaanxiXiaoniNeural      Voice = "zh-CN-shaanxi-XiaoniNeural"     // 晓妮，Female
+	VoiceZhCNShandongYunxiangNeural   Voice = "zh-CN-shandong-YunxiangNeural"  // 云翔，Male
+	VoiceZhCNSichuanYunxiNeural       Voice = "zh-CN-sichuan-YunxiNeural"      // 云希四川，Male
+	VoiceZhHKHiuMaanNeural            Voice = "zh-HK-HiuMaanNeural"            // 曉曼，Female
+	VoiceZhHKWanLungNeural            Voice = "zh-HK-WanLungNeural"            // 雲龍，Male
+	VoiceZhHKHiuGaaiNeural            Voice = "zh-HK-HiuGaaiNeural"            // 曉佳，Female
+	VoiceZhTWHsiaoChenNeural          Voice = "zh-TW-HsiaoChenNeural"          // 曉臻，Female
+	VoiceZhTWYunJheNeural             Voice = "zh-TW-YunJheNeural"             // 雲哲，Male
+	VoiceZhTWHsiaoYuNeural            Voice = "zh-TW-HsiaoYuNeural"            // 曉雨，Female
+	VoiceZuZAThandoNeural             Voice = "zu-ZA-ThandoNeural"             // Thando，Female
+	VoiceZuZAThembaNeural             Voice = "zu-ZA-ThembaNeural"             // Themba，Male
+)
+
+// 语音列表中的全部区域设置
+const (
+	LocaleAfZA         Locale = "af-ZA"          // Afrikaans (South Africa)
+	LocaleAmET         Locale = "am-ET"          // Amharic (Ethiopia)
+	LocaleArAE         Locale = "ar-AE"          // Arabic (United Arab Emirates)
+	LocaleArBH         Locale = "ar-BH"          // Arabic (Bahrain)
+	LocaleArDZ         Locale = "ar-DZ"          // Arabic (Algeria)
+	LocaleArEG         Locale = "ar-EG"          // Arabic (Egypt)
+	LocaleArIQ         Locale = "ar-IQ"          // Arabic (Iraq)
+	LocaleArJO         Locale = "ar-JO"          // Arabic (Jordan)
+	LocaleArKW         Locale = "ar-KW"          // Arabic (Kuwait)
+	LocaleArLB         Locale = "ar-LB"          // Arabic (Lebanon)
+	LocaleArLY         Locale = "ar-LY"          // Arabic (Libya)
+	LocaleArMA         Locale = "ar-MA"          // Arabic (Morocco)
+	LocaleArOM         Locale = "ar-OM"          // Arabic (Oman)
+	LocaleArQA         Locale = "ar-QA"          // Arabic (Qatar)
+	LocaleArSA         Locale = "ar-SA"          // Arabic (Saudi Arabia)
+	LocaleArSY         Locale = "ar-SY"          // Arabic (Syria)
+	LocaleArTN         Locale = "ar-TN"          // Arabic (Tunisia)
+	LocaleArYE         Locale = "ar-YE"          // Arabic (Yemen)
+	LocaleAzAZ         Locale = "az-AZ"          // Azerbaijani (Latin, Azerbaijan)
+	LocaleBgBG         Locale = "bg-BG"          // Bulgarian (Bulgaria)
+	LocaleBnBD         Locale = "bn-BD"          // Bangla (Bangladesh)
+	LocaleBnIN         Locale = "bn-IN"          // Bengali (India)
+	LocaleBsBA         Locale = "bs-BA"          // Bosnian (Bosnia and Herzegovina)
+	LocaleCaES         Locale = "ca-ES"          // Catalan (Spain)
+	LocaleCsCZ         Locale = "cs-CZ"          // Czech (Czechia)
+	LocaleCyGB         Locale = "cy-GB"          // Welsh (United Kingdom)
+	LocaleDaDK         Locale = "da-DK"          // Danish (Denmark)
+	LocaleDeAT         Locale = "de-AT"          // German (Austria)
+	LocaleDeCH         Locale = "de-CH"          // German (Switzerland)
+	LocaleDeDE         Locale = "de-DE"          // German (Germany)
+	LocaleElGR         Locale = "el-GR"          // Greek (Greece)
+	LocaleEnAU         Locale = "en-AU"          // English (Australia)
+	LocaleEnCA         Locale = "en-CA"          // English (Canada)
+	LocaleEnGB         Locale = "en-GB"          // English (United Kingdom)
+	LocaleEnHK         Locale = "en-HK"          // English (Hong Kong SAR)
+	LocaleEnIE         Locale = "en-IE"          // English (Ireland)
+	LocaleEnIN         Locale = "en-IN"          // English (India)
+	LocaleEnKE         Locale = "en-KE"          // English (Kenya)
+	LocaleEnNG         Locale = "en-NG"          // English (Nigeria)
+	LocaleEnNZ         Locale = "en-NZ"          // English (New Zealand)
+	LocaleEnPH         Locale = "en-PH"          // English (Philippines)
+	LocaleEnSG         Locale = "en-SG"          // English (Singapore)
+	LocaleEnTZ         Locale = "en-TZ"          // English (Tanzania)
+	LocaleEnUS         Locale = "en-US"          // English (United States)
+	LocaleEnZA         Locale = "en-ZA"          // English (South Africa)
+	LocaleEsAR         Locale = "es-AR"          // Spanish (Argentina)
+	LocaleEsBO         Locale = "es-BO"          // Spanish (Bolivia)
+	LocaleEsCL         Locale = "es-CL"          // Spanish (Chile)
+	LocaleEsCO         Locale = "es-CO"          // Spanish (Colombia)
+	LocaleEsCR         Locale = "es-CR"          // Spanish (Costa Rica)
+	LocaleEsCU         Locale = "es-CU"          // Spanish (Cuba)
+	LocaleEsDO         Locale = "es-DO"          // Spanish (Dominican Republic)
+	LocaleEsEC         Locale = "es-EC"          // Spanish (Ecuador)
+	LocaleEsES         Locale = "es-ES"          // Spanish (Spain)
+	LocaleEsGQ         Locale = "es-GQ"          // Spanish (Equatorial Guinea)
+	LocaleEsGT         Locale = "es-GT"          // Spanish (Guatemala)
+	LocaleEsHN         Locale = "es-HN"          // Spanish (Honduras)
+	LocaleEsMX         Locale = "es-MX"          // Spanish (Mexico)
+	LocaleEsNI         Locale = "es-NI"          // Spanish (Nicaragua)
+	LocaleEsPA         Locale = "es-PA"          // Spanish (Panama)
+	LocaleEsPE         Locale = "es-PE"          // Spanish (Peru)
+	LocaleEsPR         Locale = "es-PR"          // Spanish (Puerto Rico)
+	LocaleEsPY         Locale = "es-PY"          // Spanish (Paraguay)
+	LocaleEsSV         Locale = "es-SV"          // Spanish (El Salvador)
+	LocaleEsUS         Locale = "es-US"          // Spanish (United States)
+	LocaleEsUY         Locale = "es-UY"          // Spanish (Uruguay)
+	LocaleEsVE         Locale = "es-VE"          // Spanish (Venezuela)
+	LocaleEtEE         Locale = "et-EE"          // Estonian (Estonia)
+	LocaleEuES         Locale = "eu-ES"          // Basque
+	LocaleFaIR         Locale = "fa-IR"          // Persian (Iran)
+	LocaleFiFI         Locale = "fi-FI"          // Finnish (Finland)
+	LocaleFilPH        Locale = "fil-PH"         // Filipino (Philippines)
+	LocaleFrBE         Locale = "fr-BE"          // French (Belgium)
+	LocaleFrCA         Locale = "fr-CA"          // French (Canada)
+	LocaleFrCH         Locale = "fr-CH"          // French (Switzerland)
+	LocaleFrFR         Locale = "fr-FR"          // French (France)
+	LocaleGaIE         Locale = "ga-IE"          // Irish (Ireland)
+	LocaleGlES         Locale = "gl-ES"          // Galician
+	LocaleGuIN         Locale = "gu-IN"          // Gujarati (India)
+	LocaleHeIL         Locale = "he-IL"          // Hebrew (Israel)
+	LocaleHiIN         Locale = "hi-IN"          // Hindi (India)
+	LocaleHrHR         Locale = "hr-HR"          // Croatian (Croatia)
+	LocaleHuHU         Locale = "hu-HU"          // Hungarian (Hungary)
+	LocaleHyAM         Locale = "hy-AM"          // Armenian (Armenia)
+	LocaleIdID         Locale = "id-ID"          // Indonesian (Indonesia)
+	LocaleIsIS         Locale = "is-IS"          // Icelandic (Iceland)
+	LocaleItIT         Locale = "it-IT"          // Italian (Italy)
+	LocaleJaJP         Locale = "ja-JP"          // Japanese (Japan)
+	LocaleJvID         Locale = "jv-ID"          // Javanese (Latin, Indonesia)
+	LocaleKaGE         Locale = "ka-GE"          // Georgian (Georgia)
+	LocaleKkKZ         Locale = "kk-KZ"          // Kazakh (Kazakhstan)
+	LocaleKmKH         Locale = "km-KH"          // Khmer (Cambodia)
+	LocaleKnIN         Locale = "kn-IN"          // Kannada (India)
+	LocaleKoKR         Locale = "ko-KR"          // Korean (Korea)
+	LocaleLoLA         Locale = "lo-LA"          // Lao (Laos)
+	LocaleLtLT         Locale = "lt-LT"          // Lithuanian (Lithuania)
+	LocaleLvLV         Locale = "lv-LV"          // Latvian (Latvia)
+	LocaleMkMK         Locale = "mk-MK"          // Macedonian (North Macedonia)
+	LocaleMlIN         Locale = "ml-IN"          // Malayalam (India)
+	LocaleMnMN         Locale = "mn-MN"          // Mongolian (Mongolia)
+	LocaleMrIN         Locale = "mr-IN"          // Marathi (India)
+	LocaleMsMY         Locale = "ms-MY"          // Malay (Malaysia)
+	LocaleMtMT         Locale = "mt-MT"          // Maltese (Malta)
+	LocaleMyMM         Locale = "my-MM"          // Burmese (Myanmar)
+	LocaleNbNO         Locale = "nb-NO"          // Norwegian Bokmål (Norway)
+	LocaleNeNP         Locale = "ne-NP"          // Nepali (Nepal)
+	LocaleNlBE         Locale = "nl-BE"          // Dutch (Belgium)
+	LocaleNlNL         Locale = "nl-NL"          // Dutch (Netherlands)
+	LocalePlPL         Locale = "pl-PL"          // Polish (Poland)
+	LocalePsAF         Locale = "ps-AF"          // Pashto (Afghanistan)
+	LocalePtBR         Locale = "pt-BR"          // Portuguese (Brazil)
+	LocalePtPT         Locale = "pt-PT"          // Portuguese (Portugal)
+	LocaleRoRO         Locale = "ro-RO"          // Romanian (Romania)
+	LocaleRuRU         Locale = "ru-RU"          // Russian (Russia)
+	LocaleSiLK         Locale = "si-LK"          // Sinhala (Sri Lanka)
+	LocaleSkSK         Locale = "sk-SK"          // Slovak (Slovakia)
+	LocaleSlSI         Locale = "sl-SI"          // Slovenian (Slovenia)
+	LocaleSoSO         Locale = "so-SO"          // Somali (Somalia)
+	LocaleSqAL         Locale = "sq-AL"          // Albanian (Albania)
+	LocaleSrLatnRS     Locale = "sr-Latn-RS"     // Serbian (Latin, Serbia)
+	LocaleSrRS         Locale = "sr-RS"          // Serbian (Cyrillic, Serbia)
+	LocaleSuID         Locale = "su-ID"          // Sundanese (Indonesia)
+	LocaleSvSE         Locale = "sv-SE"          // Swedish (Sweden)
+	LocaleSwKE         Locale = "sw-KE"          // Swahili (Kenya)
+	LocaleSwTZ         Locale = "sw-TZ"          // Swahili (Tanzania)
+	LocaleTaIN         Locale = "ta-IN"          // Tamil (India)
+	LocaleTaLK         Locale = "ta-LK"          // Tamil (Sri Lanka)
+	LocaleTaMY         Locale = "ta-MY"          // Tamil (Malaysia)
+	LocaleTaSG         Locale = "ta-SG"          // Tamil (Singapore)
+	LocaleTeIN         Locale = "te-IN"          // Telugu (India)
+	LocaleThTH         Locale = "th-TH"          // Thai (Thailand)
+	LocaleTrTR         Locale = "tr-TR"          // Turkish (Turkey)
+	LocaleUkUA         Locale = "uk-UA"          // Ukrainian (Ukraine)
+	LocaleUrIN         Locale = "ur-IN"          // Urdu (India)
+	LocaleUrPK         Locale = "ur-PK"          // Urdu (Pakistan)
+	LocaleUzUZ         Locale = "uz-UZ"          // Uzbek (Latin, Uzbekistan)
+	LocaleViVN         Locale = "vi-VN"          // Vietnamese (Vietnam)
+	LocaleWuuCN        Locale = "wuu-CN"         // Chinese (Wu, Simplified)
+	LocaleYueCN        Locale = "yue-CN"         // Chinese (Cantonese, Simplified)
+	LocaleZhCN         Locale = "zh-CN"          // Chinese (Mandarin, Simplified)
+	LocaleZhCNHenan    Locale = "zh-CN-henan"    // Chinese (Zhongyuan Mandarin Henan, Simplified)
+	LocaleZhCNLiaoning Locale = "zh-CN-liaoning" // Chinese (Northeastern Mandarin, Simplified)
+	LocaleZhCNShaanxi  Locale = "zh-CN-shaanxi"  // Chinese (Zhongyuan Mandarin Shaanxi, Simplified)
+	LocaleZhCNShandong Locale = "zh-CN-shandong" // Chinese (Jilu Mandarin, Simplified)
+	LocaleZhCNSichuan  Locale = "zh-CN-sichuan"  // Chinese (Southwestern Mandarin, Simplified)
+	LocaleZhHK         Locale = "zh-HK"          // Chinese (Cantonese, Traditional)
+	LocaleZhTW         Locale = "zh-TW"          // Chinese (Taiwanese Mandarin, Traditional)
+	LocaleZuZA         Locale = "zu-ZA"          // Zulu (South Africa)
+)
+
+// voiceMetas 语音的区域设置及性别
+var voiceMetas = map[Voice]voiceMeta{
+	VoiceAfZAAdriNeural:               {LocaleAfZA, "Female"},
+	VoiceAfZAWillemNeural:             {LocaleAfZA, "Male"},
+	VoiceAmETMekdesNeural:             {LocaleAmET, "Female"},
+	VoiceAmETAmehaNeural:              {LocaleAmET, "Male"},
+	VoiceArAEFatimaNeural:             {LocaleArAE, "Female"},
+	VoiceArAEHamdanNeural:             {LocaleArAE, "Male"},
+	VoiceArBHLailaNeural:              {LocaleArBH, "Female"},
+	VoiceArBHAliNeural:                {LocaleArBH, "Male"},
+	VoiceArDZAminaNeural:              {LocaleArDZ, "Female"},
+	VoiceArDZIsmaelNeural:             {LocaleArDZ, "Male"},
+	VoiceArEGSalmaNeural:              {LocaleArEG, "Female"},
+	VoiceArEGShakirNeural:             {LocaleArEG, "Male"},
+	VoiceArIQRanaNeural:               {LocaleArIQ, "Female"},
+	VoiceArIQBasselNeural:             {LocaleArIQ, "Male"},
+	VoiceArJOSanaNeural:               {LocaleArJO, "Female"},
+	VoiceArJOTaimNeural:               {LocaleArJO, "Male"},
+	VoiceArKWNouraNeural:              {LocaleArKW, "Female"},
+	VoiceArKWFahedNeural:              {LocaleArKW, "Male"},
+	VoiceArLBLaylaNeural:              {LocaleArLB, "Female"},
+	VoiceArLBRamiNeural:               {LocaleArLB, "Male"},
+	VoiceArLYImanNeural:               {LocaleArLY, "Female"},
+	VoiceArLYOmarNeural:               {LocaleArLY, "Male"},
+	VoiceArMAMounaNeural:              {LocaleArMA, "Female"},
+	VoiceArMAJamalNeural:              {LocaleArMA, "Male"},
+	VoiceArOMAyshaNeural:              {LocaleArOM, "Female"},
+	VoiceArOMAbdullahNeural:           {LocaleArOM, "Male"},
+	VoiceArQAAmalNeural:               {LocaleArQA, "Female"},
+	VoiceArQAMoazNeural:               {LocaleArQA, "Male"},
+	VoiceArSAZariyahNeural:            {LocaleArSA, "Female"},
+	VoiceArSAHamedNeural:              {LocaleArSA, "Male"},
+	VoiceArSYAmanyNeural:              {LocaleArSY, "Female"},
+	VoiceArSYLaithNeural:              {LocaleArSY, "Male"},
+	VoiceArTNReemNeural:               {LocaleArTN, "Female"},
+	VoiceArTNHediNeural:               {LocaleArTN, "Male"},
+	VoiceArYEMaryamNeural:             {LocaleArYE, "Female"},
+	VoiceArYESalehNeural:              {LocaleArYE, "Male"},
+	VoiceAzAZBanuNeural:               {LocaleAzAZ, "Female"},
+	VoiceAzAZBabekNeural:              {LocaleAzAZ, "Male"},
+	VoiceBgBGKalinaNeural:             {LocaleBgBG, "Female"},
+	VoiceBgBGBorislavNeural:           {LocaleBgBG, "Male"},
+	VoiceBnBDNabanitaNeural:           {LocaleBnBD, "Female"},
+	VoiceBnBDPradeepNeural:            {LocaleBnBD, "Male"},
+	VoiceBnINTanishaaNeural:           {LocaleBnIN, "Female"},
+	VoiceBnINBashkarNeural:            {LocaleBnIN, "Male"},
+	VoiceBsBAVesnaNeural:              {LocaleBsBA, "Female"},
+	VoiceBsBAGoranNeural:              {LocaleBsBA, "Male"},
+	VoiceCaESJoanaNeural:              {LocaleCaES, "Female"},
+	VoiceCaESEnricNeural:              {LocaleCaES, "Male"},
+	VoiceCaESAlbaNeural:               {LocaleCaES, "Female"},
+	VoiceCsCZVlastaNeural:             {LocaleCsCZ, "Female"},
+	VoiceCsCZAntoninNeural:            {LocaleCsCZ, "Male"},
+	VoiceCyGBNiaNeural:                {LocaleCyGB, "Female"},
+	VoiceCyGBAledNeural:               {LocaleCyGB, "Male"},
+	VoiceDaDKChristelNeural:           {LocaleDaDK, "Female"},
+	VoiceDaDKJeppeNeural:              {LocaleDaDK, "Male"},
+	VoiceDeATIngridNeural:             {LocaleDeAT, "Female"},
+	VoiceDeATJonasNeural:              {LocaleDeAT, "Male"},
+	VoiceDeCHLeniNeural:               {LocaleDeCH, "Female"},
+	VoiceDeCHJanNeural:                {LocaleDeCH, "Male"},
+	VoiceDeDEKatjaNeural:              {LocaleDeDE, "Female"},
+	VoiceDeDEConradNeural:             {LocaleDeDE, "Male"},
+	VoiceDeDEAmalaNeural:              {LocaleDeDE, "Female"},
+	VoiceDeDEBerndNeural:              {LocaleDeDE, "Male"},
+	VoiceDeDEChristophNeural:          {LocaleDeDE, "Male"},
+	VoiceDeDEElkeNeural:               {LocaleDeDE, "Female"},
+	VoiceDeDEGiselaNeural:             {LocaleDeDE, "Female"},
+	VoiceDeDEKasperNeural:             {LocaleDeDE, "Male"},
+	VoiceDeDEKillianNeural:            {LocaleDeDE, "Male"},
+	VoiceDeDEKlarissaNeural:           {LocaleDeDE, "Female"},
+	VoiceDeDEKlausNeural:              {LocaleDeDE, "Male"},
+	VoiceDeDELouisaNeural:             {LocaleDeDE, "Female"},
+	VoiceDeDEMajaNeural:               {LocaleDeDE, "Female"},
+	VoiceDeDERalfNeural:               {LocaleDeDE, "Male"},
+	VoiceDeDETanjaNeural:              {LocaleDeDE, "Female"},
+	VoiceElGRAthinaNeural:             {LocaleElGR, "Female"},
+	VoiceElGRNestorasNeural:           {LocaleElGR, "Male"},
+	VoiceEnAUNatashaNeural:            {LocaleEnAU, "Female"},
+	VoiceEnAUWilliamNeural:            {LocaleEnAU, "Male"},
+	VoiceEnAUAnnetteNeural:            {LocaleEnAU, "Female"},
+	VoiceEnAUCarlyNeural:              {LocaleEnAU, "Female"},
+	VoiceEnAUDarrenNeural:             {LocaleEnAU, "Male"},
+	VoiceEnAUDuncanNeural:             {LocaleEnAU, "Male"},
+	VoiceEnAUElsieNeural:              {LocaleEnAU, "Female"},
+	VoiceEnAUFreyaNeural:              {LocaleEnAU, "Female"},
+	VoiceEnAUJoanneNeural:             {LocaleEnAU, "Female"},
+	VoiceEnAUKenNeural:                {LocaleEnAU, "Male"},
+	VoiceEnAUKimNeural:                {LocaleEnAU, "Female"},
+	VoiceEnAUNeilNeural:               {LocaleEnAU, "Male"},
+	VoiceEnAUTimNeural:                {LocaleEnAU, "Male"},
+	VoiceEnAUTinaNeural:               {LocaleEnAU, "Female"},
+	VoiceEnCAClaraNeural:              {LocaleEnCA, "Female"},
+	VoiceEnCALiamNeural:               {LocaleEnCA, "Male"},
+	VoiceEnGBSoniaNeural:              {LocaleEnGB, "Female"},
+	VoiceEnGBRyanNeural:               {LocaleEnGB, "Male"},
+	VoiceEnGBLibbyNeural:              {LocaleEnGB, "Female"},
+	VoiceEnGBAbbiNeural:               {LocaleEnGB, "Female"},
+	VoiceEnGBAlfieNeural:              {LocaleEnGB, "Male"},
+	VoiceEnGBBellaNeural:              {LocaleEnGB, "Female"},
+	VoiceEnGBElliotNeural:             {LocaleEnGB, "Male"},
+	VoiceEnGBEthanNeural:              {LocaleEnGB, "Male"},
+	VoiceEnGBHollieNeural:             {LocaleEnGB, "Female"},
+	VoiceEnGBMaisieNeural:             {LocaleEnGB, "Female"},
+	VoiceEnGBNoahNeural:               {LocaleEnGB, "Male"},
+	VoiceEnGBOliverNeural:             {LocaleEnGB, "Male"},
+	VoiceEnGBOliviaNeural:             {LocaleEnGB, "Female"},
+	VoiceEnGBThomasNeural:             {LocaleEnGB, "Male"},
+	VoiceEnGBMiaNeural:                {LocaleEnGB, "Female"},
+	VoiceEnHKYanNeural:                {LocaleEnHK, "Female"},
+	VoiceEnHKSamNeural:                {LocaleEnHK, "Male"},
+	VoiceEnIEEmilyNeural:              {LocaleEnIE, "Female"},
+	VoiceEnIEConnorNeural:             {LocaleEnIE, "Male"},
+	VoiceEnINNeerjaNeural:             {LocaleEnIN, "Female"},
+	VoiceEnINPrabhatNeural:            {LocaleEnIN, "Male"},
+	VoiceEnKEAsiliaNeural:             {LocaleEnKE, "Female"},
+	VoiceEnKEChilembaNeural:           {LocaleEnKE, "Male"},
+	VoiceEnNGEzinneNeural:             {LocaleEnNG, "Female"},
+	VoiceEnNGAbeoNeural:               {LocaleEnNG, "Male"},
+	VoiceEnNZMollyNeural:              {LocaleEnNZ, "Female"},
+	VoiceEnNZMitchellNeural:           {LocaleEnNZ, "Male"},
+	VoiceEnPHRosaNeural:               {LocaleEnPH, "Female"},
+	VoiceEnPHJamesNeural:              {LocaleEnPH, "Male"},
+	VoiceEnSGLunaNeural:               {LocaleEnSG, "Female"},
+	VoiceEnSGWayneNeural:              {LocaleEnSG, "Male"},
+	VoiceEnTZImaniNeural:              {LocaleEnTZ, "Female"},
+	VoiceEnTZElimuNeural:              {LocaleEnTZ, "Male"},
+	VoiceEnUSAvaNeural:                {LocaleEnUS, "Female"},
+	VoiceEnUSAndrewNeural:             {LocaleEnUS, "Male"},
+	VoiceEnUSEmmaNeural:               {LocaleEnUS, "Female"},
+	VoiceEnUSBrianNeural:              {LocaleEnUS, "Male"},
+	VoiceEnUSJennyNeural:              {LocaleEnUS, "Female"},
+	VoiceEnUSGuyNeural:                {LocaleEnUS, "Male"},
+	VoiceEnUSAriaNeural:               {LocaleEnUS, "Female"},
+	VoiceEnUSDavisNeural:              {LocaleEnUS, "Male"},
+	VoiceEnUSJaneNeural:               {LocaleEnUS, "Female"},
+	VoiceEnUSJasonNeural:              {LocaleEnUS, "Male"},
+	VoiceEnUSSaraNeural:               {LocaleEnUS, "Female"},
+	VoiceEnUSTonyNeural:               {LocaleEnUS, "Male"},
+	VoiceEnUSNancyNeural:              {LocaleEnUS, "Female"},
+	VoiceEnUSAmberNeural:              {LocaleEnUS, "Female"},
+	VoiceEnUSAnaNeural:                {LocaleEnUS, "Female"},
+	VoiceEnUSAndrewMultilingualNeural: {LocaleEnUS, "Male"},
+	VoiceEnUSAshleyNeural:             {LocaleEnUS, "Female"},
+	VoiceEnUSAvaMultilingualNeural:    {LocaleEnUS, "Female"},
+	VoiceEnUSBrandonNeural:            {LocaleEnUS, "Male"},
+	VoiceEnUSBrianMultilingualNeural:  {LocaleEnUS, "Male"},
+	VoiceEnUSChristopherNeural:        {LocaleEnUS, "Male"},
+	VoiceEnUSCoraNeural:               {LocaleEnUS, "Female"},
+	VoiceEnUSElizabethNeural:          {LocaleEnUS, "Female"},
+	VoiceEnUSEmmaMultilingualNeural:   {LocaleEnUS, "Female"},
+	VoiceEnUSEricNeural:               {LocaleEnUS, "Male"},
+	VoiceEnUSJacobNeural:              {LocaleEnUS, "Male"},
+	VoiceEnUSJennyMultilingualNeural:  {LocaleEnUS, "Female"},
+	VoiceEnUSMichelleNeural:           {LocaleEnUS, "Female"},
+	VoiceEnUSMonicaNeural:             {LocaleEnUS, "Female"},
+	VoiceEnUSRogerNeural:              {LocaleEnUS, "Male"},
+	VoiceEnUSRyanMultilingualNeural:   {LocaleEnUS, "Male"},
+	VoiceEnUSSteffanNeural:            {LocaleEnUS, "Male"},
+	VoiceEnZALeahNeural:               {LocaleEnZA, "Female"},
+	VoiceEnZALukeNeural:               {LocaleEnZA, "Male"},
+	VoiceEsARElenaNeural:              {LocaleEsAR, "Female"},
+	VoiceEsARTomasNeural:              {LocaleEsAR, "Male"},
+	VoiceEsBOSofiaNeural:              {LocaleEsBO, "Female"},
+	VoiceEsBOMarceloNeural:            {LocaleEsBO, "Male"},
+	VoiceEsCLCatalinaNeural:           {LocaleEsCL, "Female"},
+	VoiceEsCLLorenzoNeural:            {LocaleEsCL, "Male"},
+	VoiceEsCOSalomeNeural:             {LocaleEsCO, "Female"},
+	VoiceEsCOGonzaloNeural:            {LocaleEsCO, "Male"},
+	VoiceEsCRMariaNeural:              {LocaleEsCR, "Female"},
+	VoiceEsCRJuanNeural:               {LocaleEsCR, "Male"},
+	VoiceEsCUBelkysNeural:             {LocaleEsCU, "Female"},
+	VoiceEsCUManuelNeural:             {LocaleEsCU, "Male"},
+	VoiceEsDORamonaNeural:             {LocaleEsDO, "Female"},
+	VoiceEsDOEmilioNeural:             {LocaleEsDO, "Male"},
+	VoiceEsECAndreaNeural:             {LocaleEsEC, "Female"},
+	VoiceEsECLuisNeural:               {LocaleEsEC, "Male"},
+	VoiceEsESElviraNeural:             {LocaleEsES, "Female"},
+	VoiceEsESAlvaroNeural:             {LocaleEsES, "Male"},
+	VoiceEsESAbrilNeural:              {LocaleEsES, "Female"},
+	VoiceEsESArnauNeural:              {LocaleEsES, "Male"},
+	VoiceEsESDarioNeural:              {LocaleEsES, "Male"},
+	VoiceEsESEliasNeural:              {LocaleEsES, "Male"},
+	VoiceEsESEstrellaNeural:           {LocaleEsES, "Female"},
+	VoiceEsESIreneNeural:              {LocaleEsES, "Female"},
+	VoiceEsESLaiaNeural:               {LocaleEsES, "Female"},
+	VoiceEsESLiaNeural:                {LocaleEsES, "Female"},
+	VoiceEsESNilNeural:                {LocaleEsES, "Male"},
+	VoiceEsESSaulNeural:               {LocaleEsES, "Male"},
+	VoiceEsESTeoNeural:                {LocaleEsES, "Male"},
+	VoiceEsESTrianaNeural:             {LocaleEsES, "Female"},
+	VoiceEsESVeraNeural:               {LocaleEsES, "Female"},
+	VoiceEsGQTeresaNeural:             {LocaleEsGQ, "Female"},
+	VoiceEsGQJavierNeural:             {LocaleEsGQ, "Male"},
+	VoiceEsGTMartaNeural:              {LocaleEsGT, "Female"},
+	VoiceEsGTAndresNeural:             {LocaleEsGT, "Male"},
+	VoiceEsHNKarlaNeural:              {LocaleEsHN, "Female"},
+	VoiceEsHNCarlosNeural:             {LocaleEsHN, "Male"},
+	VoiceEsMXDaliaNeural:              {LocaleEsMX, "Female"},
+	VoiceEsMXJorgeNeural:              {LocaleEsMX, "Male"},
+	VoiceEsMXBeatrizNeural:            {LocaleEsMX, "Female"},
+	VoiceEsMXCandelaNeural:            {LocaleEsMX, "Female"},
+	VoiceEsMXCarlotaNeural:            {LocaleEsMX, "Female"},
+	VoiceEsMXCecilioNeural:            {LocaleEsMX, "Male"},
+	VoiceEsMXGerardoNeural:            {LocaleEsMX, "Male"},
+	VoiceEsMXLarissaNeural:            {LocaleEsMX, "Female"},
+	VoiceEsMXLibertoNeural:            {LocaleEsMX, "Male"},
+	VoiceEsMXLucianoNeural:            {LocaleEsMX, "Male"},
+	VoiceEsMXMarinaNeural:             {LocaleEsMX, "Female"},
+	VoiceEsMXNuriaNeural:              {LocaleEsMX, "Female"},
+	VoiceEsMXPelayoNeural:             {LocaleEsMX, "Male"},
+	VoiceEsMXRenataNeural:             {LocaleEsMX, "Female"},
+	VoiceEsMXYagoNeural:               {LocaleEsMX, "Male"},
+	VoiceEsNIYolandaNeural:            {LocaleEsNI, "Female"},
+	VoiceEsNIFedericoNeural:           {LocaleEsNI, "Male"},
+	VoiceEsPAMargaritaNeural:          {LocaleEsPA, "Female"},
+	VoiceEsPARobertoNeural:            {LocaleEsPA, "Male"},
+	VoiceEsPECamilaNeural:             {LocaleEsPE, "Female"},
+	VoiceEsPEAlexNeural:               {LocaleEsPE, "Male"},
+	VoiceEsPRKarinaNeural:             {LocaleEsPR, "Female"},
+	VoiceEsPRVictorNeural:             {LocaleEsPR, "Male"},
+	VoiceEsPYTaniaNeural:              {LocaleEsPY, "Female"},
+	VoiceEsPYMarioNeural:              {LocaleEsPY, "Male"},
+	VoiceEsSVLorenaNeural:             {LocaleEsSV, "Female"},
+	VoiceEsSVRodrigoNeural:            {LocaleEsSV, "Male"},
+	VoiceEsUSPalomaNeural:             {LocaleEsUS, "Female"},
+	VoiceEsUSAlonsoNeural:             {LocaleEsUS, "Male"},
+	VoiceEsUYValentinaNeural:          {LocaleEsUY, "Female"},
+	VoiceEsUYMateoNeural:              {LocaleEsUY, "Male"},
+	VoiceEsVEPaolaNeural:              {LocaleEsVE, "Female"},
+	VoiceEsVESebastianNeural:          {LocaleEsVE, "Male"},
+	VoiceEtEEAnuNeural:                {LocaleEtEE, "Female"},
+	VoiceEtEEKertNeural:               {LocaleEtEE, "Male"},
+	VoiceEuESAinhoaNeural:             {LocaleEuES, "Female"},
+	VoiceEuESAnderNeural:              {LocaleEuES, "Male"},
+	VoiceFaIRDilaraNeural:             {LocaleFaIR, "Female"},
+	VoiceFaIRFaridNeural:              {LocaleFaIR, "Male"},
+	VoiceFiFISelmaNeural:              {LocaleFiFI, "Female"},
+	VoiceFiFIHarriNeural:              {LocaleFiFI, "Male"},
+	VoiceFiFINooraNeural:              {LocaleFiFI, "Female"},
+	VoiceFilPHBlessicaNeural:          {LocaleFilPH, "Female"},
+	VoiceFilPHAngeloNeural:            {LocaleFilPH, "Male"},
+	VoiceFrBECharlineNeural:           {LocaleFrBE, "Female"},
+	VoiceFrBEGerardNeural:             {LocaleFrBE, "Male"},
+	VoiceFrCASylvieNeural:             {LocaleFrCA, "Female"},
+	VoiceFrCAJeanNeural:               {LocaleFrCA, "Male"},
+	VoiceFrCAAntoineNeural:            {LocaleFrCA, "Male"},
+	VoiceFrCHArianeNeural:             {LocaleFrCH, "Female"},
+	VoiceFrCHFabriceNeural:            {LocaleFrCH, "Male"},
+	VoiceFrFRDeniseNeural:             {LocaleFrFR, "Female"},
+	VoiceFrFRHenriNeural:              {LocaleFrFR, "Male"},
+	VoiceFrFRAlainNeural:              {LocaleFrFR, "Male"},
+	VoiceFrFRBrigitteNeural:           {LocaleFrFR, "Female"},
+	VoiceFrFRCelesteNeural:            {LocaleFrFR, "Female"},
+	VoiceFrFRClaudeNeural:             {LocaleFrFR, "Male"},
+	VoiceFrFRCoralieNeural:            {LocaleFrFR, "Female"},
+	VoiceFrFREloiseNeural:             {LocaleFrFR, "Female"},
+	VoiceFrFRJacquelineNeural:         {LocaleFrFR, "Female"},
+	VoiceFrFRJeromeNeural:             {LocaleFrFR, "Male"},
+	VoiceFrFRJosephineNeural:          {LocaleFrFR, "Female"},
+	VoiceFrFRMauriceNeural:            {LocaleFrFR, "Male"},
+	VoiceFrFRYvesNeural:               {LocaleFrFR, "Male"},
+	VoiceFrFRYvetteNeural:             {LocaleFrFR, "Female"},
+	VoiceGaIEOrlaNeural:               {LocaleGaIE, "Female"},
+	VoiceGaIEColmNeural:               {LocaleGaIE, "Male"},
+	VoiceGlESSabelaNeural:             {LocaleGlES, "Female"},
+	VoiceGlESRoiNeural:                {LocaleGlES, "Male"},
+	VoiceGuINDhwaniNeural:             {LocaleGuIN, "Female"},
+	VoiceGuINNiranjanNeural:           {LocaleGuIN, "Male"},
+	VoiceHeILHilaNeural:               {LocaleHeIL, "Female"},
+	VoiceHeILAvriNeural:               {LocaleHeIL, "Male"},
+	VoiceHiINSwaraNeural:              {LocaleHiIN, "Female"},
+	VoiceHiINMadhurNeural:             {LocaleHiIN, "Male"},
+	VoiceHrHRGabrijelaNeural:          {LocaleHrHR, "Female"},
+	VoiceHrHRSreckoNeural:             {LocaleHrHR, "Male"},
+	VoiceHuHUNoemiNeural:              {LocaleHuHU, "Female"},
+	VoiceHuHUTamasNeural:              {LocaleHuHU, "Male"},
+	VoiceHyAMAnahitNeural:             {LocaleHyAM, "Female"},
+	VoiceHyAMHaykNeural:               {LocaleHyAM, "Male"},
+	VoiceIdIDGadisNeural:              {LocaleIdID, "Female"},
+	VoiceIdIDArdiNeural:               {LocaleIdID, "Male"},
+	VoiceIsISGudrunNeural:             {LocaleIsIS, "Female"},
+	VoiceIsISGunnarNeural:             {LocaleIsIS, "Male"},
+	VoiceItITElsaNeural:               {LocaleItIT, "Female"},
+	VoiceItITIsabellaNeural:           {LocaleItIT, "Female"},
+	VoiceItITDiegoNeural:              {LocaleItIT, "Male"},
+	VoiceItITBenignoNeural:            {LocaleItIT, "Male"},
+	VoiceItITCalimeroNeural:           {LocaleItIT, "Male"},
+	VoiceItITCataldoNeural:            {LocaleItIT, "Male"},
+	VoiceItITFabiolaNeural:            {LocaleItIT, "Female"},
+	VoiceItITFiammaNeural:             {LocaleItIT, "Female"},
+	VoiceItITGianniNeural:             {LocaleItIT, "Male"},
+	VoiceItITImeldaNeural:             {LocaleItIT, "Female"},
+	VoiceItITIrmaNeural:               {LocaleItIT, "Female"},
+	VoiceItITLisandroNeural:           {LocaleItIT, "Male"},
+	VoiceItITPalmiraNeural:            {LocaleItIT, "Female"},
+	VoiceItITPierinaNeural:            {LocaleItIT, "Female"},
+	VoiceItITRinaldoNeural:            {LocaleItIT, "Male"},
+	VoiceJaJPNanamiNeural:             {LocaleJaJP, "Female"},
+	VoiceJaJPKeitaNeural:              {LocaleJaJP, "Male"},
+	VoiceJaJPAoiNeural:                {LocaleJaJP, "Female"},
+	VoiceJaJPDaichiNeural:             {LocaleJaJP, "Male"},
+	VoiceJaJPMayuNeural:               {LocaleJaJP, "Female"},
+	VoiceJaJPNaokiNeural:              {LocaleJaJP, "Male"},
+	VoiceJaJPShioriNeural:             {LocaleJaJP, "Female"},
+	VoiceJvIDSitiNeural:               {LocaleJvID, "Female"},
+	VoiceJvIDDimasNeural:              {LocaleJvID, "Male"},
+	VoiceKaGEEkaNeural:                {LocaleKaGE, "Female"},
+	VoiceKaGEGiorgiNeural:             {LocaleKaGE, "Male"},
+	VoiceKkKZAigulNeural:              {LocaleKkKZ, "Female"},
+	VoiceKkKZDauletNeural:             {LocaleKkKZ, "Male"},
+	VoiceKmKHSreymomNeural:            {LocaleKmKH, "Female"},
+	VoiceKmKHPisethNeural:             {LocaleKmKH, "Male"},
+	VoiceKnINSapnaNeural:              {LocaleKnIN, "Female"},
+	VoiceKnINGaganNeural:              {LocaleKnIN, "Male"},
+	VoiceKoKRSunHiNeural:              {LocaleKoKR, "Female"},
+	VoiceKoKRInJoonNeural:             {LocaleKoKR, "Male"},
+	VoiceKoKRBongJinNeural:            {LocaleKoKR, "Male"},
+	VoiceKoKRGookMinNeural:            {LocaleKoKR, "Male"},
+	VoiceKoKRJiMinNeural:              {LocaleKoKR, "Female"},
+	VoiceKoKRSeoHyeonNeural:           {LocaleKoKR, "Female"},
+	VoiceKoKRSoonBokNeural:            {LocaleKoKR, "Female"},
+	VoiceKoKRYuJinNeural:              {LocaleKoKR, "Female"},
+	VoiceLoLAKeomanyNeural:            {LocaleLoLA, "Female"},
+	VoiceLoLAChanthavongNeural:        {LocaleLoLA, "Male"},
+	VoiceLtLTOnaNeural:                {LocaleLtLT, "Female"},
+	VoiceLtLTLeonasNeural:             {LocaleLtLT, "Male"},
+	VoiceLvLVEveritaNeural:            {LocaleLvLV, "Female"},
+	VoiceLvLVNilsNeural:               {LocaleLvLV, "Male"},
+	VoiceMkMKMarijaNeural:             {LocaleMkMK, "Female"},
+	VoiceMkMKAleksandarNeural:         {LocaleMkMK, "Male"},
+	VoiceMlINSobhanaNeural:            {LocaleMlIN, "Female"},
+	VoiceMlINMidhunNeural:             {LocaleMlIN, "Male"},
+	VoiceMnMNYesuiNeural:              {LocaleMnMN, "Female"},
+	VoiceMnMNBataaNeural:              {LocaleMnMN, "Male"},
+	VoiceMrINAarohiNeural:             {LocaleMrIN, "Female"},
+	VoiceMrINManoharNeural:            {LocaleMrIN, "Male"},
+	VoiceMsMYYasminNeural:             {LocaleMsMY, "Female"},
+	VoiceMsMYOsmanNeural:              {LocaleMsMY, "Male"},
+	VoiceMtMTGraceNeural:              {LocaleMtMT, "Female"},
+	VoiceMtMTJosephNeural:             {LocaleMtMT, "Male"},
+	VoiceMyMMNilarNeural:              {LocaleMyMM, "Female"},
+	VoiceMyMMThihaNeural:              {LocaleMyMM, "Male"},
+	VoiceNbNOPernilleNeural:           {LocaleNbNO, "Female"},
+	VoiceNbNOFinnNeural:               {LocaleNbNO, "Male"},
+	VoiceNbNOIselinNeural:             {LocaleNbNO, "Female"},
+	VoiceNeNPHemkalaNeural:            {LocaleNeNP, "Female"},
+	VoiceNeNPSagarNeural:              {LocaleNeNP, "Male"},
+	VoiceNlBEDenaNeural:               {LocaleNlBE, "Female"},
+	VoiceNlBEArnaudNeural:             {LocaleNlBE, "Male"},
+	VoiceNlNLFennaNeural:              {LocaleNlNL, "Female"},
+	VoiceNlNLMaartenNeural:            {LocaleNlNL, "Male"},
+	VoiceNlNLColetteNeural:            {LocaleNlNL, "Female"},
+	VoicePlPLAgnieszkaNeural:          {LocalePlPL, "Female"},
+	VoicePlPLMarekNeural:              {LocalePlPL, "Male"},
+	VoicePlPLZofiaNeural:              {LocalePlPL, "Female"},
+	VoicePsAFLatifaNeural:             {LocalePsAF, "Female"},
+	VoicePsAFGulNawazNeural:           {LocalePsAF, "Male"},
+	VoicePtBRFranciscaNeural:          {LocalePtBR, "Female"},
+	VoicePtBRAntonioNeural:            {LocalePtBR, "Male"},
+	VoicePtBRBrendaNeural:             {LocalePtBR, "Female"},
+	VoicePtBRDonatoNeural:             {LocalePtBR, "Male"},
+	VoicePtBRElzaNeural:               {LocalePtBR, "Female"},
+	VoicePtBRFabioNeural:              {LocalePtBR, "Male"},
+	VoicePtBRGiovannaNeural:           {LocalePtBR, "Female"},
+	VoicePtBRHumbertoNeural:           {LocalePtBR, "Male"},
+	VoicePtBRJulioNeural:              {LocalePtBR, "Male"},
+	VoicePtBRLeilaNeural:              {LocalePtBR, "Female"},
+	VoicePtBRLeticiaNeural:            {LocalePtBR, "Female"},
+	VoicePtBRManuelaNeural:            {LocalePtBR, "Female"},
+	VoicePtBRNicolauNeural:            {LocalePtBR, "Male"},
+	VoicePtBRValerioNeural:            {LocalePtBR, "Male"},
+	VoicePtBRYaraNeural:               {LocalePtBR, "Female"},
+	VoicePtPTRaquelNeural:             {LocalePtPT, "Female"},
+	VoicePtPTDuarteNeural:             {LocalePtPT, "Male"},
+	VoicePtPTFernandaNeural:           {LocalePtPT, "Female"},
+	VoiceRoROAlinaNeural:              {LocaleRoRO, "Female"},
+	VoiceRoROEmilNeural:               {LocaleRoRO, "Male"},
+	VoiceRuRUSvetlanaNeural:           {LocaleRuRU, "Female"},
+	VoiceRuRUDmitryNeural:             {LocaleRuRU, "Male"},
+	VoiceRuRUDariyaNeural:             {LocaleRuRU, "Female"},
+	VoiceSiLKThiliniNeural:            {LocaleSiLK, "Female"},
+	VoiceSiLKSameeraNeural:            {LocaleSiLK, "Male"},
+	VoiceSkSKViktoriaNeural:           {LocaleSkSK, "Female"},
+	VoiceSkSKLukasNeural:              {LocaleSkSK, "Male"},
+	VoiceSlSIPetraNeural:              {LocaleSlSI, "Female"},
+	VoiceSlSIRokNeural:                {LocaleSlSI, "Male"},
+	VoiceSoSOUbaxNeural:               {LocaleSoSO, "Female"},
+	VoiceSoSOMuuseNeural:              {LocaleSoSO, "Male"},
+	VoiceSqALAnilaNeural:              {LocaleSqAL, "Female"},
+	VoiceSqALIlirNeural:               {LocaleSqAL, "Male"},
+	VoiceSrLatnRSNicholasNeural:       {LocaleSrLatnRS, "Male"},
+	VoiceSrLatnRSSophieNeural:         {LocaleSrLatnRS, "Female"},
+	VoiceSrRSSophieNeural:             {LocaleSrRS, "Female"},
+	VoiceSrRSNicholasNeural:           {LocaleSrRS, "Male"},
+	VoiceSuIDTutiNeural:               {LocaleSuID, "Female"},
+	VoiceSuIDJajangNeural:             {LocaleSuID, "Male"},
+	VoiceSvSESofieNeural:              {LocaleSvSE, "Female"},
+	VoiceSvSEMattiasNeural:            {LocaleSvSE, "Male"},
+	VoiceSvSEHilleviNeural:            {LocaleSvSE, "Female"},
+	VoiceSwKEZuriNeural:               {LocaleSwKE, "Female"},
+	VoiceSwKERafikiNeural:             {LocaleSwKE, "Male"},
+	VoiceSwTZRehemaNeural:             {LocaleSwTZ, "Female"},
+	VoiceSwTZDaudiNeural:              {LocaleSwTZ, "Male"},
+	VoiceTaINPallaviNeural:            {LocaleTaIN, "Female"},
+	VoiceTaINValluvarNeural:           {LocaleTaIN, "Male"},
+	VoiceTaLKSaranyaNeural:            {LocaleTaLK, "Female"},
+	VoiceTaLKKumarNeural:              {LocaleTaLK, "Male"},
+	VoiceTaMYKaniNeural:               {LocaleTaMY, "Female"},
+	VoiceTaMYSuryaNeural:              {LocaleTaMY, "Male"},
+	VoiceTaSGVenbaNeural:              {LocaleTaSG, "Female"},
+	VoiceTaSGAnbuNeural:               {LocaleTaSG, "Male"},
+	VoiceTeINShrutiNeural:             {LocaleTeIN, "Female"},
+	VoiceTeINMohanNeural:              {LocaleTeIN, "Male"},
+	VoiceThTHPremwadeeNeural:          {LocaleThTH, "Female"},
+	VoiceThTHNiwatNeural:              {LocaleThTH, "Male"},
+	VoiceThTHAcharaNeural:             {LocaleThTH, "Female"},
+	VoiceTrTREmelNeural:               {LocaleTrTR, "Female"},
+	VoiceTrTRAhmetNeural:              {LocaleTrTR, "Male"},
+	VoiceUkUAPolinaNeural:             {LocaleUkUA, "Female"},
+	VoiceUkUAOstapNeural:              {LocaleUkUA, "Male"},
+	VoiceUrINGulNeural:                {LocaleUrIN, "Female"},
+	VoiceUrINSalmanNeural:             {LocaleUrIN, "Male"},
+	VoiceUrPKUzmaNeural:               {LocaleUrPK, "Female"},
+	VoiceUrPKAsadNeural:               {LocaleUrPK, "Male"},
+	VoiceUzUZMadinaNeural:             {LocaleUzUZ, "Female"},
+	VoiceUzUZSardorNeural:             {LocaleUzUZ, "Male"},
+	VoiceViVNHoaiMyNeural:             {LocaleViVN, "Female"},
+	VoiceViVNNamMinhNeural:            {LocaleViVN, "Male"},
+	VoiceWuuCNXiaotongNeural:          {LocaleWuuCN, "Female"},
+	VoiceWuuCNYunzheNeural:            {LocaleWuuCN, "Male"},
+	VoiceYueCNXiaoMinNeural:           {LocaleYueCN, "Female"},
+	VoiceYueCNYunSongNeural:           {LocaleYueCN, "Male"},
+	VoiceZhCNXiaoxiaoNeural:           {LocaleZhCN, "Female"},
+	VoiceZhCNYunxiNeural:              {LocaleZhCN, "Male"},
+	VoiceZhCNYunjianNeural:            {LocaleZhCN, "Male"},
+	VoiceZhCNXiaoyiNeural:             {LocaleZhCN, "Female"},
+	VoiceZhCNYunyangNeural:            {LocaleZhCN, "Male"},
+	VoiceZhCNXiaochenNeural:           {LocaleZhCN, "Female"},
+	VoiceZhCNXiaohanNeural:            {LocaleZhCN, "Female"},
+	VoiceZhCNXiaomengNeural:           {LocaleZhCN, "Female"},
+	VoiceZhCNXiaomoNeural:             {LocaleZhCN, "Female"},
+	VoiceZhCNXiaoqiuNeural:            {LocaleZhCN, "Female"},
+	VoiceZhCNXiaoruiNeural:            {LocaleZhCN, "Female"},
+	VoiceZhCNXiaoshuangNeural:         {LocaleZhCN, "Female"},
+	VoiceZhCNXiaoyanNeural:            {LocaleZhCN, "Female"},
+	VoiceZhCNXiaoyouNeural:            {LocaleZhCN, "Female"},
+	VoiceZhCNXiaozhenNeural:           {LocaleZhCN, "Female"},
+	VoiceZhCNYunfengNeural:            {LocaleZhCN, "Male"},
+	VoiceZhCNYunhaoNeural:             {LocaleZhCN, "Male"},
+	VoiceZhCNYunxiaNeural:             {LocaleZhCN, "Male"},
+	VoiceZhCNYunyeNeural:              {LocaleZhCN, "Male"},
+	VoiceZhCNYunzeNeural:              {LocaleZhCN, "Male"},
+	VoiceZhCNXiaoxuanNeural:           {LocaleZhCN, "Female"},
+	VoiceZhCNHenanYundengNeural:       {LocaleZhCNHenan, "Male"},
+	VoiceZhCNLiaoningXiaobeiNeural:    {LocaleZhCNLiaoning, "Female"},
+	VoiceZhCNShaanxiXiaoniNeural:      {LocaleZhCNShaanxi, "Female"},
+	VoiceZhCNShandongYunxiangNeural:   {LocaleZhCNShandong, "Male"},
+	VoiceZhCNSichuanYunxiNeural:       {LocaleZhCNSichuan, "Male"},
+	VoiceZhHKHiuMaanNeural:            {LocaleZhHK, "Female"},
+	VoiceZhHKWanLungNeural:            {LocaleZhHK, "Male"},
+	VoiceZhHKHiuGaaiNeural:            {LocaleZhHK, "Female"},
+	VoiceZhTWHsiaoChenNeural:          {LocaleZhTW, "Female"},
+	VoiceZhTWYunJheNeural:             {LocaleZhTW, "Male"},
+	VoiceZhTWHsiaoYuNeural:            {LocaleZhTW, "Female"},
+	VoiceZuZAThandoNeural:             {LocaleZuZA, "Female"},
+	VoiceZuZAThembaNeural:             {LocaleZuZA, "Male"},
+}